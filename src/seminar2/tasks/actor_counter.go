@@ -0,0 +1,75 @@
+package tasks
+
+import "sync"
+
+// ActorCounter — потокобезопасный счетчик, все изменения которого выполняет одна горутина,
+// получающая их через канал. После Close счетчиком пользоваться нельзя: вызовы методов паникуют
+type ActorCounter struct {
+	ops       chan func(*int)
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewActorCounter создает счетчик с начальным значением c и запускает его горутину
+func NewActorCounter(c int) *ActorCounter {
+	a := &ActorCounter{
+		ops:  make(chan func(*int)),
+		done: make(chan struct{}),
+	}
+	go a.run(c)
+	return a
+}
+
+func (a *ActorCounter) run(value int) {
+	defer close(a.done)
+	for op := range a.ops {
+		op(&value)
+	}
+}
+
+// Increment увеличивает счетчик на 1
+func (a *ActorCounter) Increment() {
+	a.Add(1)
+}
+
+// Decrement уменьшает счетчик на 1
+func (a *ActorCounter) Decrement() {
+	a.Add(-1)
+}
+
+// GetValue возвращает текущее значение счетчика
+func (a *ActorCounter) GetValue() int {
+	reply := make(chan int, 1)
+	a.ops <- func(value *int) {
+		reply <- *value
+	}
+	return <-reply
+}
+
+// Reset обнуляет счетчик
+func (a *ActorCounter) Reset() {
+	a.ops <- func(value *int) {
+		*value = 0
+	}
+}
+
+// Add прибавляет n к счетчику
+func (a *ActorCounter) Add(n int) {
+	a.ops <- func(value *int) {
+		*value += n
+	}
+}
+
+// Subtract вычитает n из счетчика
+func (a *ActorCounter) Subtract(n int) {
+	a.Add(-n)
+}
+
+// Close останавливает горутину счетчика и дожидается ее завершения.
+// Повторные вызовы ничего не делают
+func (a *ActorCounter) Close() {
+	a.closeOnce.Do(func() {
+		close(a.ops)
+	})
+	<-a.done
+}
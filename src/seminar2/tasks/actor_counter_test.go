@@ -0,0 +1,129 @@
+package tasks
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorCounter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		initial  int
+		actions  func(*ActorCounter)
+		expected int
+	}{
+		{
+			name:     "initial value",
+			initial:  7,
+			actions:  func(c *ActorCounter) {},
+			expected: 7,
+		},
+		{
+			name:    "multiple operations",
+			initial: 0,
+			actions: func(c *ActorCounter) {
+				c.Increment()
+				c.Increment()
+				c.Add(5)
+				c.Decrement()
+				c.Subtract(3)
+			},
+			expected: 3,
+		},
+		{
+			name:    "reset counter",
+			initial: 10,
+			actions: func(c *ActorCounter) {
+				c.Reset()
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := NewActorCounter(tt.initial)
+			defer cnt.Close()
+			tt.actions(cnt)
+			assert.Equal(t, tt.expected, cnt.GetValue())
+		})
+	}
+}
+
+func TestActorCounterConcurrent(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewActorCounter(0)
+	defer cnt.Close()
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				cnt.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 5000, cnt.GetValue())
+}
+
+func TestActorCounterClose(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewActorCounter(1)
+	cnt.Close()
+	cnt.Close()
+
+	assert.Panics(t, func() { cnt.Increment() })
+}
+
+// mutexCounter — счетчик под мьютексом для сравнения в бенчмарках
+type mutexCounter struct {
+	mu      sync.Mutex
+	counter *Counter[int]
+}
+
+func (m *mutexCounter) Increment() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counter.Increment()
+}
+
+func BenchmarkCounters(b *testing.B) {
+	b.Run("mutex", func(b *testing.B) {
+		cnt := &mutexCounter{counter: NewCounter(0)}
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				cnt.Increment()
+			}
+		})
+	})
+
+	b.Run("atomic", func(b *testing.B) {
+		cnt := NewAtomicCounter(0)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				cnt.Increment()
+			}
+		})
+	})
+
+	b.Run("actor", func(b *testing.B) {
+		cnt := NewActorCounter(0)
+		defer cnt.Close()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				cnt.Increment()
+			}
+		})
+	})
+}
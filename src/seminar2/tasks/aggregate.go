@@ -0,0 +1,85 @@
+package tasks
+
+import "cmp"
+
+// MinBy возвращает элемент xs с наименьшим ключом key (первый при равенстве) и true,
+// либо нулевое значение и false для пустого xs
+func MinBy[T any, K cmp.Ordered](xs []T, key func(T) K) (T, bool) {
+	i := argBy(xs, key, func(a, b K) bool { return a < b })
+	return at(xs, i)
+}
+
+// MaxBy возвращает элемент xs с наибольшим ключом key (первый при равенстве) и true,
+// либо нулевое значение и false для пустого xs
+func MaxBy[T any, K cmp.Ordered](xs []T, key func(T) K) (T, bool) {
+	i := argBy(xs, key, func(a, b K) bool { return a > b })
+	return at(xs, i)
+}
+
+// ArgMin возвращает индекс первого наименьшего элемента xs или -1 для пустого xs
+func ArgMin[T cmp.Ordered](xs []T) int {
+	return argBy(xs, identity[T], func(a, b T) bool { return a < b })
+}
+
+// ArgMax возвращает индекс первого наибольшего элемента xs или -1 для пустого xs
+func ArgMax[T cmp.Ordered](xs []T) int {
+	return argBy(xs, identity[T], func(a, b T) bool { return a > b })
+}
+
+// Sum возвращает сумму элементов xs; для пустого xs — ноль
+func Sum[T Number](xs []T) T {
+	return SumBy(xs, identity[T])
+}
+
+// SumBy возвращает сумму значений f по элементам xs; для пустого xs — ноль
+func SumBy[T any, N Number](xs []T, f func(T) N) N {
+	var sum N
+	for _, x := range xs {
+		sum += f(x)
+	}
+	return sum
+}
+
+// Mean возвращает среднее арифметическое xs и true, либо 0 и false для пустого xs
+func Mean[T Number](xs []T) (float64, bool) {
+	return MeanBy(xs, identity[T])
+}
+
+// MeanBy возвращает среднее значений f по элементам xs и true, либо 0 и false для пустого xs
+func MeanBy[T any, N Number](xs []T, f func(T) N) (float64, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += float64(f(x))
+	}
+	return sum / float64(len(xs)), true
+}
+
+// argBy возвращает индекс первого элемента, ключ которого лучше всех по better, или -1
+func argBy[T any, K any](xs []T, key func(T) K, better func(a, b K) bool) int {
+	if len(xs) == 0 {
+		return -1
+	}
+	best, bestKey := 0, key(xs[0])
+	for i := 1; i < len(xs); i++ {
+		if k := key(xs[i]); better(k, bestKey) {
+			best, bestKey = i, k
+		}
+	}
+	return best
+}
+
+// at возвращает xs[i] и true или нулевое значение и false, если i < 0
+func at[T any](xs []T, i int) (T, bool) {
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+	return xs[i], true
+}
+
+func identity[T any](x T) T {
+	return x
+}
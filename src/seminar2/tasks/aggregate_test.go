@@ -0,0 +1,92 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type product struct {
+	name  string
+	price float64
+	stock int
+}
+
+var products = []product{
+	{"pen", 1.5, 100},
+	{"book", 12, 5},
+	{"lamp", 30, 5},
+	{"cup", 1.5, 40},
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	t.Parallel()
+
+	byPrice := func(p product) float64 { return p.price }
+
+	cheapest, ok := MinBy(products, byPrice)
+	assert.True(t, ok)
+	assert.Equal(t, "pen", cheapest.name, "first element wins a tie")
+
+	dearest, ok := MaxBy(products, byPrice)
+	assert.True(t, ok)
+	assert.Equal(t, "lamp", dearest.name)
+
+	_, ok = MinBy(nil, byPrice)
+	assert.False(t, ok)
+	_, ok = MaxBy(nil, byPrice)
+	assert.False(t, ok)
+}
+
+func TestArgMinArgMax(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  []int
+		argMin int
+		argMax int
+	}{
+		{"distinct", []int{3, 1, 4, 5, 2}, 1, 3},
+		{"ties pick first", []int{2, 1, 5, 1, 5}, 1, 2},
+		{"single element", []int{7}, 0, 0},
+		{"empty input", nil, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.argMin, ArgMin(tt.input))
+			assert.Equal(t, tt.argMax, ArgMax(tt.input))
+		})
+	}
+}
+
+func TestSum(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 10, Sum([]int{1, 2, 3, 4}))
+	assert.InDelta(t, 0.6, Sum([]float64{0.1, 0.2, 0.3}), 1e-9)
+	assert.Equal(t, 3*time.Second, Sum([]time.Duration{time.Second, 2 * time.Second}))
+	assert.Equal(t, 0, Sum[int](nil))
+
+	assert.Equal(t, 150, SumBy(products, func(p product) int { return p.stock }))
+	assert.InDelta(t, 45.0, SumBy(products, func(p product) float64 { return p.price }), 1e-9)
+}
+
+func TestMean(t *testing.T) {
+	t.Parallel()
+
+	mean, ok := Mean([]int{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.5, mean, 1e-9)
+
+	mean, ok = MeanBy(products, func(p product) int { return p.stock })
+	assert.True(t, ok)
+	assert.InDelta(t, 37.5, mean, 1e-9)
+
+	mean, ok = Mean[float64](nil)
+	assert.False(t, ok)
+	assert.Zero(t, mean)
+}
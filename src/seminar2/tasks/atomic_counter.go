@@ -0,0 +1,119 @@
+package tasks
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"sync/atomic"
+)
+
+// AtomicCounter — потокобезопасный счетчик на sync/atomic.
+// Нулевое значение готово к использованию
+type AtomicCounter struct {
+	value atomic.Int64
+
+	// mu и cond нужны только для WaitUntil; waiters позволяет не трогать их, пока никто не ждет
+	mu      sync.Mutex
+	cond    *sync.Cond
+	waiters atomic.Int32
+}
+
+// NewAtomicCounter создает потокобезопасный счетчик с начальным значением c
+func NewAtomicCounter(c int) *AtomicCounter {
+	counter := &AtomicCounter{}
+	counter.value.Store(int64(c))
+	return counter
+}
+
+// Increment увеличивает счетчик на 1
+func (c *AtomicCounter) Increment() {
+	c.value.Add(1)
+	c.notify()
+}
+
+// Decrement уменьшает счетчик на 1
+func (c *AtomicCounter) Decrement() {
+	c.value.Add(-1)
+	c.notify()
+}
+
+// GetValue возвращает текущее значение счетчика
+func (c *AtomicCounter) GetValue() int {
+	return int(c.value.Load())
+}
+
+// Reset обнуляет счетчик
+func (c *AtomicCounter) Reset() {
+	c.value.Store(0)
+	c.notify()
+}
+
+// Add прибавляет n к счетчику
+func (c *AtomicCounter) Add(n int) {
+	c.value.Add(int64(n))
+	c.notify()
+}
+
+// Subtract вычитает n из счетчика
+func (c *AtomicCounter) Subtract(n int) {
+	c.value.Add(-int64(n))
+	c.notify()
+}
+
+// CompareAndSwap записывает new, только если текущее значение равно old,
+// и сообщает, произошла ли замена
+func (c *AtomicCounter) CompareAndSwap(old, new int) bool {
+	if !c.value.CompareAndSwap(int64(old), int64(new)) {
+		return false
+	}
+	c.notify()
+	return true
+}
+
+// WaitUntil блокируется, пока predicate не вернет true для значения счетчика,
+// или пока не будет отменен ctx — тогда возвращается ошибка контекста
+func (c *AtomicCounter) WaitUntil(ctx context.Context, predicate func(int) bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cond == nil {
+		c.cond = sync.NewCond(&c.mu)
+	}
+
+	// Ожидающий регистрируется до проверки значения, поэтому изменение не может проскочить незамеченным
+	c.waiters.Add(1)
+	defer c.waiters.Add(-1)
+
+	stop := context.AfterFunc(ctx, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.cond.Broadcast()
+	})
+	defer stop()
+
+	for !predicate(c.GetValue()) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		c.cond.Wait()
+	}
+	return nil
+}
+
+// notify будит горутины, ожидающие в WaitUntil
+func (c *AtomicCounter) notify() {
+	if c.waiters.Load() == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cond.Broadcast()
+}
+
+// Publish регистрирует счетчик в expvar под именем name, и его значение появляется на /debug/vars.
+// Обработчик expvar читает значение из своей горутины, поэтому публиковать можно только потокобезопасный счетчик.
+// Как и expvar.Publish, паникует, если имя уже занято
+func (c *AtomicCounter) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return c.GetValue()
+	}))
+}
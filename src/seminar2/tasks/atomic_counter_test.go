@@ -0,0 +1,173 @@
+package tasks
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtomicCounter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		initial  int
+		actions  func(*AtomicCounter)
+		expected int
+	}{
+		{
+			name:     "initial value",
+			initial:  7,
+			actions:  func(c *AtomicCounter) {},
+			expected: 7,
+		},
+		{
+			name:    "multiple operations",
+			initial: 0,
+			actions: func(c *AtomicCounter) {
+				c.Increment()
+				c.Increment()
+				c.Add(5)
+				c.Decrement()
+				c.Subtract(3)
+			},
+			expected: 3,
+		},
+		{
+			name:    "reset counter",
+			initial: 10,
+			actions: func(c *AtomicCounter) {
+				c.Reset()
+			},
+			expected: 0,
+		},
+		{
+			name:    "successful compare and swap",
+			initial: 1,
+			actions: func(c *AtomicCounter) {
+				c.CompareAndSwap(1, 42)
+			},
+			expected: 42,
+		},
+		{
+			name:    "failed compare and swap",
+			initial: 1,
+			actions: func(c *AtomicCounter) {
+				c.CompareAndSwap(2, 42)
+			},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := NewAtomicCounter(tt.initial)
+			tt.actions(cnt)
+			assert.Equal(t, tt.expected, cnt.GetValue())
+		})
+	}
+}
+
+func TestAtomicCounterConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var cnt AtomicCounter
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				cnt.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100_000, cnt.GetValue())
+}
+
+func TestAtomicCounterWaitUntil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("already satisfied", func(t *testing.T) {
+		t.Parallel()
+		cnt := NewAtomicCounter(5)
+		assert.NoError(t, cnt.WaitUntil(context.Background(), func(v int) bool { return v >= 5 }))
+	})
+
+	t.Run("waits for workers", func(t *testing.T) {
+		t.Parallel()
+		var cnt AtomicCounter
+		for range 10 {
+			go func() {
+				for range 100 {
+					cnt.Increment()
+				}
+			}()
+		}
+
+		err := cnt.WaitUntil(context.Background(), func(v int) bool { return v == 1000 })
+		assert.NoError(t, err)
+		assert.Equal(t, 1000, cnt.GetValue())
+	})
+
+	t.Run("several waiters", func(t *testing.T) {
+		t.Parallel()
+		var cnt AtomicCounter
+		var wg sync.WaitGroup
+		for target := 1; target <= 3; target++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, cnt.WaitUntil(context.Background(), func(v int) bool { return v >= target }))
+			}()
+		}
+		for range 3 {
+			cnt.Increment()
+		}
+		wg.Wait()
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		t.Parallel()
+		cnt := NewAtomicCounter(0)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := cnt.WaitUntil(ctx, func(v int) bool { return v > 0 })
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestAtomicCounterPublish(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewAtomicCounter(1)
+	cnt.Publish("tasks_test_atomic_counter")
+	cnt.Add(41)
+
+	published := expvar.Get("tasks_test_atomic_counter")
+	assert.Equal(t, "42", published.String())
+	assert.Panics(t, func() { NewAtomicCounter(0).Publish("tasks_test_atomic_counter") })
+
+	// expvar читает значение из горутины HTTP-обработчика, пока владелец меняет счетчик; go test -race это проверяет
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range 1000 {
+			cnt.Increment()
+		}
+	}()
+	for range 1000 {
+		_ = published.String()
+	}
+	wg.Wait()
+	assert.Equal(t, "1042", published.String())
+}
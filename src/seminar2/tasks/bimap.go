@@ -0,0 +1,92 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrBiMapConflict возвращается BiMap.Put, если ключ или значение уже связаны с другой парой
+var ErrBiMapConflict = errors.New("bimap conflict")
+
+// BiMap — взаимно однозначное отображение с поиском в обе стороны за O(1).
+// Каждый ключ связан ровно с одним значением, и каждое значение — ровно с одним ключом
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// NewBiMap создает пустое отображение
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K)}
+}
+
+// Put связывает key и value. Если key уже связан с другим значением или value — с другим ключом,
+// отображение не меняется и возвращается ErrBiMapConflict
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if old, ok := m.forward[key]; ok && old != value {
+		return fmt.Errorf("%w: key %v is bound to %v", ErrBiMapConflict, key, old)
+	}
+	if old, ok := m.inverse[value]; ok && old != key {
+		return fmt.Errorf("%w: value %v is bound to %v", ErrBiMapConflict, value, old)
+	}
+	m.forward[key] = value
+	m.inverse[value] = key
+	return nil
+}
+
+// ForcePut связывает key и value, удаляя пары, которые конфликтуют с новой
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	m.DeleteKey(key)
+	m.DeleteValue(value)
+	m.forward[key] = value
+	m.inverse[value] = key
+}
+
+// Get возвращает значение, связанное с key
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetKey возвращает ключ, связанный с value
+func (m *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := m.inverse[value]
+	return k, ok
+}
+
+// DeleteKey удаляет пару по ключу и сообщает, была ли она
+func (m *BiMap[K, V]) DeleteKey(key K) bool {
+	v, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.inverse, v)
+	}
+	return ok
+}
+
+// DeleteValue удаляет пару по значению и сообщает, была ли она
+func (m *BiMap[K, V]) DeleteValue(value V) bool {
+	return m.Inverse().DeleteKey(value)
+}
+
+// Len возвращает количество пар
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Inverse возвращает обратное отображение; оно использует те же данные, и изменения видны в обоих
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: m.inverse, inverse: m.forward}
+}
+
+// All перечисляет пары в произвольном порядке
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
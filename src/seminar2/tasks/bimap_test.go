@@ -0,0 +1,97 @@
+package tasks
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMapPut(t *testing.T) {
+	t.Parallel()
+
+	m := NewBiMap[string, int]()
+	assert.NoError(t, m.Put("one", 1))
+	assert.NoError(t, m.Put("two", 2))
+	assert.NoError(t, m.Put("one", 1), "the same pair can be put again")
+
+	tests := []struct {
+		name  string
+		key   string
+		value int
+	}{
+		{"key bound to another value", "one", 3},
+		{"value bound to another key", "uno", 1},
+		{"both bound", "one", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, m.Put(tt.key, tt.value), ErrBiMapConflict)
+		})
+	}
+
+	t.Cleanup(func() {
+		assert.Equal(t, map[string]int{"one": 1, "two": 2}, maps.Collect(m.All()))
+	})
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	t.Parallel()
+
+	m := NewBiMap[string, int]()
+	m.ForcePut("one", 1)
+	m.ForcePut("two", 2)
+	m.ForcePut("one", 2)
+
+	assert.Equal(t, 1, m.Len())
+	k, ok := m.GetKey(2)
+	assert.True(t, ok)
+	assert.Equal(t, "one", k)
+	_, ok = m.GetKey(1)
+	assert.False(t, ok)
+	_, ok = m.Get("two")
+	assert.False(t, ok)
+}
+
+func TestBiMapLookupAndDelete(t *testing.T) {
+	t.Parallel()
+
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	k, ok := m.GetKey(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+
+	assert.True(t, m.DeleteValue(1))
+	assert.False(t, m.DeleteKey("a"))
+	assert.True(t, m.DeleteKey("b"))
+	assert.Equal(t, 0, m.Len())
+	_, ok = m.GetKey(2)
+	assert.False(t, ok)
+}
+
+func TestBiMapInverse(t *testing.T) {
+	t.Parallel()
+
+	m := NewBiMap[string, int]()
+	m.ForcePut("a", 1)
+
+	inv := m.Inverse()
+	k, ok := inv.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+
+	assert.NoError(t, inv.Put(2, "b"))
+	v, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, m.Len())
+}
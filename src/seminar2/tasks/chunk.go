@@ -0,0 +1,72 @@
+package tasks
+
+import "time"
+
+// Chunk разбивает xs на части по n элементов; последняя часть может быть короче.
+// Части ссылаются на память xs, но их нельзя расширить через append на соседние элементы.
+// Для n <= 0 возвращает nil
+func Chunk[T any](xs []T, n int) [][]T {
+	if n <= 0 {
+		return nil
+	}
+	var chunks [][]T
+	for i := 0; i < len(xs); i += n {
+		end := min(i+n, len(xs))
+		chunks = append(chunks, xs[i:end:end])
+	}
+	return chunks
+}
+
+// SlidingWindow возвращает все окна из n подряд идущих элементов xs со сдвигом 1.
+// Если элементов меньше n или n <= 0, возвращает nil
+func SlidingWindow[T any](xs []T, n int) [][]T {
+	if n <= 0 || len(xs) < n {
+		return nil
+	}
+	windows := make([][]T, 0, len(xs)-n+1)
+	for i := 0; i+n <= len(xs); i++ {
+		windows = append(windows, xs[i:i+n:i+n])
+	}
+	return windows
+}
+
+// Batch читает элементы из in и отправляет их пачками в возвращаемый канал.
+// Пачка отправляется, когда в ней набралось maxSize элементов или с прихода ее первого элемента
+// прошло maxDelay. Неположительные maxSize и maxDelay снимают соответствующее ограничение.
+// Когда in закрывается, остаток отправляется последней пачкой и выходной канал закрывается
+func Batch[T any](in <-chan T, maxSize int, maxDelay time.Duration) <-chan []T {
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var timeout <-chan time.Time
+		flush := func() {
+			if len(batch) > 0 {
+				out <- batch
+			}
+			batch = nil
+			timeout = nil
+		}
+
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 && maxDelay > 0 {
+					timeout = time.After(maxDelay)
+				}
+				batch = append(batch, item)
+				if maxSize > 0 && len(batch) >= maxSize {
+					flush()
+				}
+			case <-timeout:
+				flush()
+			}
+		}
+	}()
+	return out
+}
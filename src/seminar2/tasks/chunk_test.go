@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected [][]int
+	}{
+		{"even split", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"short last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"chunk larger than input", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty input", nil, 3, nil},
+		{"non-positive size", []int{1, 2}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Chunk(tt.input, tt.n))
+		})
+	}
+}
+
+func TestChunkDoesNotAlias(t *testing.T) {
+	t.Parallel()
+
+	xs := []int{1, 2, 3, 4}
+	chunks := Chunk(xs, 2)
+	_ = append(chunks[0], 100)
+
+	assert.Equal(t, []int{1, 2, 3, 4}, xs)
+}
+
+func TestSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected [][]int
+	}{
+		{"windows of two", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"window equals input", []int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{"input too short", []int{1, 2}, 3, nil},
+		{"non-positive size", []int{1, 2}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, SlidingWindow(tt.input, tt.n))
+		})
+	}
+}
+
+func TestBatchBySize(t *testing.T) {
+	t.Parallel()
+
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 1; i <= 7; i++ {
+			in <- i
+		}
+	}()
+
+	var batches [][]int
+	for batch := range Batch(in, 3, 0) {
+		batches = append(batches, batch)
+	}
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, batches)
+}
+
+func TestBatchByDelay(t *testing.T) {
+	t.Parallel()
+
+	in := make(chan string)
+	out := Batch(in, 100, 20*time.Millisecond)
+
+	in <- "a"
+	in <- "b"
+	assert.Equal(t, []string{"a", "b"}, <-out, "partial batch is flushed after the delay")
+
+	in <- "c"
+	close(in)
+	assert.Equal(t, []string{"c"}, <-out)
+
+	_, ok := <-out
+	assert.False(t, ok)
+}
@@ -0,0 +1,111 @@
+package tasks
+
+import (
+	"iter"
+	"slices"
+)
+
+// Permutations лениво перечисляет все перестановки xs в лексикографическом порядке индексов.
+// Для пустого xs выдается одна пустая перестановка. Каждая перестановка — новый слайс
+func Permutations[T any](xs []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		indices := Range(0, len(xs), 1)
+		for {
+			if !yield(pick(xs, indices)) {
+				return
+			}
+			if !nextPermutation(indices) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations лениво перечисляет все сочетания из k элементов xs в лексикографическом порядке индексов.
+// Для k == 0 выдается одно пустое сочетание, для k < 0 или k > len(xs) — ни одного
+func Combinations[T any](xs []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(xs) {
+			return
+		}
+		indices := Range(0, k, 1)
+		for {
+			if !yield(pick(xs, indices)) {
+				return
+			}
+			// Ищем самый правый индекс, который еще можно увеличить
+			i := k - 1
+			for i >= 0 && indices[i] == len(xs)-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// CartesianProduct лениво перечисляет все наборы, составленные из одного элемента каждого слайса,
+// причем последний слайс меняется быстрее всего. Без слайсов выдается один пустой набор,
+// а если какой-то слайс пуст — ни одного
+func CartesianProduct[T any](xss ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, xs := range xss {
+			if len(xs) == 0 {
+				return
+			}
+		}
+		indices := make([]int, len(xss))
+		for {
+			tuple := make([]T, len(xss))
+			for i, j := range indices {
+				tuple[i] = xss[i][j]
+			}
+			if !yield(tuple) {
+				return
+			}
+			// Увеличиваем индексы как разряды числа
+			i := len(xss) - 1
+			for i >= 0 && indices[i] == len(xss[i])-1 {
+				indices[i] = 0
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+		}
+	}
+}
+
+// pick возвращает новый слайс из элементов xs с индексами indices
+func pick[T any](xs []T, indices []int) []T {
+	result := make([]T, len(indices))
+	for i, j := range indices {
+		result[i] = xs[j]
+	}
+	return result
+}
+
+// nextPermutation переставляет indices в следующую лексикографическую перестановку
+// и сообщает false, если текущая была последней
+func nextPermutation(indices []int) bool {
+	i := len(indices) - 2
+	for i >= 0 && indices[i] >= indices[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(indices) - 1
+	for indices[j] <= indices[i] {
+		j--
+	}
+	indices[i], indices[j] = indices[j], indices[i]
+	slices.Reverse(indices[i+1:])
+	return true
+}
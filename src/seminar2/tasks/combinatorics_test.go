@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermutations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []string
+		expected [][]string
+	}{
+		{"three elements", []string{"a", "b", "c"}, [][]string{
+			{"a", "b", "c"}, {"a", "c", "b"}, {"b", "a", "c"},
+			{"b", "c", "a"}, {"c", "a", "b"}, {"c", "b", "a"},
+		}},
+		{"single element", []string{"x"}, [][]string{{"x"}}},
+		{"empty input", nil, [][]string{{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, slices.Collect(Permutations(tt.input)))
+		})
+	}
+}
+
+func TestPermutationsCount(t *testing.T) {
+	t.Parallel()
+
+	count := 0
+	for range Permutations([]int{1, 2, 3, 4, 5, 6}) {
+		count++
+	}
+	assert.Equal(t, 720, count)
+}
+
+func TestCombinations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		k        int
+		expected [][]int
+	}{
+		{"choose two of four", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{"choose all", []int{1, 2}, 2, [][]int{{1, 2}}},
+		{"choose zero", []int{1, 2}, 0, [][]int{{}}},
+		{"k too large", []int{1, 2}, 3, nil},
+		{"negative k", []int{1, 2}, -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, slices.Collect(Combinations(tt.input, tt.k)))
+		})
+	}
+}
+
+func TestCartesianProduct(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected [][]int
+	}{
+		{"two slices", [][]int{{1, 2}, {3, 4, 5}}, [][]int{{1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}}},
+		{"three slices", [][]int{{0, 1}, {0, 1}, {7}}, [][]int{{0, 0, 7}, {0, 1, 7}, {1, 0, 7}, {1, 1, 7}}},
+		{"no slices", nil, [][]int{{}}},
+		{"empty slice", [][]int{{1, 2}, {}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, slices.Collect(CartesianProduct(tt.input...)))
+		})
+	}
+}
+
+func TestCombinatoricsEarlyStop(t *testing.T) {
+	t.Parallel()
+
+	// Перебор большого пространства прекращается, как только решение найдено
+	xs := Range(0, 20, 1)
+	var found []int
+	for combo := range Combinations(xs, 3) {
+		if Sum(combo) == 50 {
+			found = combo
+			break
+		}
+	}
+	assert.Equal(t, []int{13, 18, 19}, found)
+}
@@ -0,0 +1,113 @@
+package tasks
+
+import (
+	"hash/maphash"
+	"sync"
+)
+
+// defaultShards — число сегментов ConcurrentMap, если конструктору передано неположительное значение
+const defaultShards = 32
+
+// ConcurrentMap — потокобезопасная хеш-таблица, разбитая на сегменты со своими блокировками.
+// Горутины, работающие с ключами из разных сегментов, не мешают друг другу
+type ConcurrentMap[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []mapShard[K, V]
+}
+
+type mapShard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	items map[K]V
+}
+
+// NewConcurrentMap создает таблицу из shards сегментов; при shards <= 0 используется defaultShards
+func NewConcurrentMap[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	if shards <= 0 {
+		shards = defaultShards
+	}
+	m := &ConcurrentMap[K, V]{seed: maphash.MakeSeed(), shards: make([]mapShard[K, V], shards)}
+	for i := range m.shards {
+		m.shards[i].items = make(map[K]V)
+	}
+	return m
+}
+
+// Get возвращает значение по ключу и признак его наличия
+func (m *ConcurrentMap[K, V]) Get(key K) (V, bool) {
+	shard := m.shard(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	v, ok := shard.items[key]
+	return v, ok
+}
+
+// Set записывает значение по ключу
+func (m *ConcurrentMap[K, V]) Set(key K, value V) {
+	shard := m.shard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	shard.items[key] = value
+}
+
+// Delete удаляет ключ и сообщает, был ли он в таблице
+func (m *ConcurrentMap[K, V]) Delete(key K) bool {
+	shard := m.shard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	_, ok := shard.items[key]
+	delete(shard.items, key)
+	return ok
+}
+
+// Compute атомарно обновляет значение ключа: f получает текущее значение и признак его наличия
+// и возвращает новое значение и признак, нужно ли его сохранить (false удаляет ключ).
+// Пока выполняется f, сегмент ключа заблокирован, поэтому f не должна обращаться к таблице
+func (m *ConcurrentMap[K, V]) Compute(key K, f func(old V, ok bool) (V, bool)) (V, bool) {
+	shard := m.shard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	old, ok := shard.items[key]
+	v, keep := f(old, ok)
+	if keep {
+		shard.items[key] = v
+	} else {
+		delete(shard.items, key)
+	}
+	return v, keep
+}
+
+// Len возвращает количество ключей. При конкурентных изменениях результат приблизителен
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mu.RLock()
+		n += len(shard.items)
+		shard.mu.RUnlock()
+	}
+	return n
+}
+
+// Range вызывает f для каждой пары, пока f возвращает true. Сегменты обходятся по снимкам,
+// поэтому f может менять таблицу, а изменения, сделанные во время обхода, могут быть не видны
+func (m *ConcurrentMap[K, V]) Range(f func(K, V) bool) {
+	for i := range m.shards {
+		shard := &m.shards[i]
+		shard.mu.RLock()
+		snapshot := make(map[K]V, len(shard.items))
+		for k, v := range shard.items {
+			snapshot[k] = v
+		}
+		shard.mu.RUnlock()
+
+		for k, v := range snapshot {
+			if !f(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *ConcurrentMap[K, V]) shard(key K) *mapShard[K, V] {
+	return &m.shards[maphash.Comparable(m.seed, key)%uint64(len(m.shards))]
+}
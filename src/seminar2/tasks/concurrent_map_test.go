@@ -0,0 +1,111 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	t.Parallel()
+
+	m := NewConcurrentMap[string, int](4)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	_, ok = m.Get("c")
+	assert.False(t, ok)
+	assert.Equal(t, 2, m.Len())
+
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	t.Parallel()
+
+	m := NewConcurrentMap[string, int](0)
+
+	v, kept := m.Compute("x", func(old int, ok bool) (int, bool) {
+		assert.False(t, ok)
+		return old + 5, true
+	})
+	assert.Equal(t, 5, v)
+	assert.True(t, kept)
+
+	_, kept = m.Compute("x", func(old int, ok bool) (int, bool) {
+		assert.True(t, ok)
+		assert.Equal(t, 5, old)
+		return 0, false
+	})
+	assert.False(t, kept)
+	_, ok := m.Get("x")
+	assert.False(t, ok)
+}
+
+func TestConcurrentMapParallelCompute(t *testing.T) {
+	t.Parallel()
+
+	const goroutines, perGoroutine = 8, 1000
+	m := NewConcurrentMap[string, int](8)
+
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perGoroutine {
+				key := fmt.Sprintf("key-%d", i%10)
+				m.Compute(key, func(old int, _ bool) (int, bool) { return old + 1, true })
+				m.Set(fmt.Sprintf("g%d-%d", g, i), i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	m.Range(func(k string, v int) bool {
+		if strings.HasPrefix(k, "key-") {
+			total += v
+		}
+		return true
+	})
+	assert.Equal(t, goroutines*perGoroutine, total)
+	assert.Equal(t, 10+goroutines*perGoroutine, m.Len())
+}
+
+func TestConcurrentMapRange(t *testing.T) {
+	t.Parallel()
+
+	m := NewConcurrentMap[int, int](3)
+	for i := range 10 {
+		m.Set(i, i*i)
+	}
+
+	seen := make(map[int]int)
+	m.Range(func(k, v int) bool {
+		seen[k] = v
+		m.Delete(k)
+		return true
+	})
+	assert.Len(t, seen, 10)
+	assert.Equal(t, 81, seen[9])
+	assert.Equal(t, 0, m.Len())
+
+	m.Set(1, 1)
+	m.Set(2, 2)
+	calls := 0
+	m.Range(func(int, int) bool {
+		calls++
+		return false
+	})
+	assert.Equal(t, 1, calls)
+}
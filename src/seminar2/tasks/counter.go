@@ -1,33 +1,292 @@
 package tasks
 
-type counter struct {
-	value int
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrCounterOverflow возвращается в режиме BoundError, если значение превысило бы максимум
+	ErrCounterOverflow = errors.New("counter overflow")
+	// ErrCounterUnderflow возвращается в режиме BoundError, если значение опустилось бы ниже минимума
+	ErrCounterUnderflow = errors.New("counter underflow")
+)
+
+// Number — числовые типы, которые может считать Counter
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// BoundMode определяет поведение счетчика при выходе за границы
+type BoundMode int
+
+const (
+	// BoundClamp останавливает значение на границе (насыщение)
+	BoundClamp BoundMode = iota
+	// BoundWrap заворачивает значение по модулю hi-lo в полуинтервал [lo, hi)
+	BoundWrap
+	// BoundError оставляет значение прежним и возвращает ErrCounterOverflow или ErrCounterUnderflow
+	BoundError
+)
+
+// CounterOption — опция для настройки счетчика при создании
+type CounterOption[T Number] func(*Counter[T])
+
+// WithBounds ограничивает значение счетчика отрезком [lo, hi], а в режиме BoundWrap — полуинтервалом [lo, hi).
+// Начальное значение приводится в границы. Некорректные границы (lo > hi, а для BoundWrap и lo == hi) игнорируются
+func WithBounds[T Number](lo, hi T, mode BoundMode) CounterOption[T] {
+	return func(c *Counter[T]) {
+		if lo > hi || (mode == BoundWrap && lo == hi) {
+			return
+		}
+		c.bounded = true
+		c.min, c.max = lo, hi
+		c.mode = mode
+	}
+}
+
+// Counter — счетчик с настраиваемым шагом над любым числовым типом
+type Counter[T Number] struct {
+	value T
+	step  T
+
+	bounded  bool
+	min, max T
+	mode     BoundMode
+
+	thresholds []threshold[T]
+
+	recordHistory bool
+	historyLimit  int
+	history       []Mutation[T]
 }
 
-func newCounter(c int) *counter {
-	return &counter{value: c}
+// threshold — подписка, созданная OnThreshold
+type threshold[T Number] struct {
+	level T
+	fn    func()
 }
 
-func (c *counter) Increment() {
-	c.value++
+// NewCounter создает счетчик с начальным значением c и шагом 1
+func NewCounter[T Number](c T, options ...CounterOption[T]) *Counter[T] {
+	return newCounter(c, 1, options)
 }
 
-func (c *counter) Decrement() {
-	c.value--
+// NewCounterWithStep создает счетчик с нулевым начальным значением,
+// который изменяется на step при Increment и Decrement
+func NewCounterWithStep[T Number](step T, options ...CounterOption[T]) *Counter[T] {
+	return newCounter(0, step, options)
 }
 
-func (c *counter) GetValue() int {
+func newCounter[T Number](value, step T, options []CounterOption[T]) *Counter[T] {
+	c := &Counter[T]{step: step}
+	for _, option := range options {
+		option(c)
+	}
+	c.value = c.normalize(value)
+	return c
+}
+
+// Increment увеличивает счетчик на шаг
+func (c *Counter[T]) Increment() error {
+	return c.apply(OpIncrement, c.step, c.add)
+}
+
+// Decrement уменьшает счетчик на шаг
+func (c *Counter[T]) Decrement() error {
+	return c.apply(OpDecrement, c.step, c.sub)
+}
+
+// GetValue возвращает текущее значение счетчика
+func (c *Counter[T]) GetValue() T {
 	return c.value
 }
 
-func (c *counter) Reset() {
-	c.value = 0
+// Reset обнуляет счетчик; если ноль вне границ, значение приводится в границы
+func (c *Counter[T]) Reset() {
+	_ = c.apply(OpReset, 0, func(T) error {
+		c.value = c.normalize(0)
+		return nil
+	})
+}
+
+// Add прибавляет n к счетчику
+func (c *Counter[T]) Add(n T) error {
+	return c.apply(OpAdd, n, c.add)
+}
+
+// Subtract вычитает n из счетчика
+func (c *Counter[T]) Subtract(n T) error {
+	return c.apply(OpSubtract, n, c.sub)
+}
+
+// OnThreshold регистрирует fn, которая вызывается каждый раз, когда значение пересекает n:
+// поднимается с меньшего n до n или выше либо опускается с n или выше ниже n
+func (c *Counter[T]) OnThreshold(n T, fn func()) {
+	c.thresholds = append(c.thresholds, threshold[T]{level: n, fn: fn})
+}
+
+// checkThresholds вызывает подписчиков, чьи пороги лежат между old и текущим значением
+func (c *Counter[T]) checkThresholds(old T) {
+	for _, t := range c.thresholds {
+		up := old < t.level && c.value >= t.level
+		down := old >= t.level && c.value < t.level
+		if up || down {
+			t.fn()
+		}
+	}
+}
+
+// apply выполняет изменение f с аргументом delta, записывает его в историю и вызывает подписчиков порогов
+func (c *Counter[T]) apply(op CounterOp, delta T, f func(T) error) error {
+	old := c.value
+	if err := f(delta); err != nil {
+		return err
+	}
+	c.record(op, delta, old)
+	c.checkThresholds(old)
+	return nil
+}
+
+// add прибавляет n с учетом границ. Выход за границы определяется по результату сложения,
+// без вычисления запаса вида max-value, поэтому переполнение T не ломает проверку даже для границ на весь диапазон
+func (c *Counter[T]) add(n T) error {
+	if !c.bounded {
+		c.value += n
+		return nil
+	}
+	if c.mode == BoundWrap {
+		c.value = c.wrapAdd(c.value, n)
+		return nil
+	}
+
+	r := c.value + n
+	overflow := n > 0 && (r < c.value || r > c.max)
+	underflow := n < 0 && (r > c.value || r < c.min)
+	return c.settle(r, overflow, underflow, "+", n)
+}
+
+// sub вычитает n с учетом границ, так же как add
+func (c *Counter[T]) sub(n T) error {
+	if !c.bounded {
+		c.value -= n
+		return nil
+	}
+	if c.mode == BoundWrap {
+		c.value = c.wrapSub(c.value, n)
+		return nil
+	}
+
+	r := c.value - n
+	overflow := n < 0 && (r < c.value || r > c.max)
+	underflow := n > 0 && (r > c.value || r < c.min)
+	return c.settle(r, overflow, underflow, "-", n)
+}
+
+// settle записывает результат r или, если он вышел за границы, насыщает значение либо возвращает ошибку
+func (c *Counter[T]) settle(r T, overflow, underflow bool, op string, n T) error {
+	switch {
+	case overflow && c.mode == BoundError:
+		return fmt.Errorf("%w: %v %s %v > %v", ErrCounterOverflow, c.value, op, n, c.max)
+	case underflow && c.mode == BoundError:
+		return fmt.Errorf("%w: %v %s %v < %v", ErrCounterUnderflow, c.value, op, n, c.min)
+	case overflow:
+		c.value = c.max
+	case underflow:
+		c.value = c.min
+	default:
+		c.value = r
+	}
+	return nil
+}
+
+// wrapAdd прибавляет n к v из [min, max) по модулю ширины полуинтервала
+func (c *Counter[T]) wrapAdd(v, n T) T {
+	n = c.reduce(n)
+	return c.wrapInto(v, v+n, n > 0)
+}
+
+// wrapSub вычитает n из v из [min, max) по модулю ширины полуинтервала
+func (c *Counter[T]) wrapSub(v, n T) T {
+	n = c.reduce(n)
+	return c.wrapInto(v, v-n, n < 0)
+}
+
+// wrapInto возвращает в [min, max) результат r сдвига v на величину меньше ширины вверх (up) или вниз.
+// Для целых типов r и ширина могут переполниться, но арифметика в дополнительном коде дает верный ответ,
+// потому что итоговое значение представимо
+func (c *Counter[T]) wrapInto(v, r T, up bool) T {
+	width := c.max - c.min
+	switch {
+	case up && (r < v || r >= c.max):
+		return r - width
+	case !up && (r > v || r < c.min):
+		return r + width
+	}
+	return r
+}
+
+// reduce приводит n к интервалу (-width, width) с сохранением знака, где width = max - min
+func (c *Counter[T]) reduce(n T) T {
+	width := c.max - c.min
+	switch {
+	case width <= 0:
+		// Ширина не помещается в T, и любое n уже меньше нее по модулю
+		return n
+	case n >= 0:
+		return mod(n, width)
+	case -n > 0:
+		return -mod(-n, width)
+	}
+	// n — минимальное значение знакового типа, у него нельзя сменить знак: |n| = -(n+1) + 1
+	k := mod(-(n+1), width) + 1
+	if k == width {
+		return 0
+	}
+	return -k
+}
+
+// normalize приводит произвольное значение в границы счетчика
+func (c *Counter[T]) normalize(value T) T {
+	switch {
+	case !c.bounded:
+		return value
+	case c.mode != BoundWrap:
+		return min(max(value, c.min), c.max)
+	case value >= c.min && value < c.max:
+		return value
+	default:
+		// min + (value - min) mod width, но без вычисления value - min, которое может переполниться
+		return c.wrapSub(c.wrapAdd(c.min, value), c.min)
+	}
 }
 
-func (c *counter) Add(n int) {
-	c.value += n
+// mod возвращает остаток от деления неотрицательного a на положительное m,
+// одинаково для целых и вещественных типов
+func mod[T Number](a, m T) T {
+	if a < m {
+		return a
+	}
+	q := a / m
+	if isFloat[T]() {
+		q = T(math.Floor(float64(q)))
+	}
+	r := a - q*m
+	// Для вещественных типов остаток может немного выйти за [0, m) из-за округления
+	for r < 0 {
+		r += m
+	}
+	for r >= m {
+		r -= m
+	}
+	return r
 }
 
-func (c *counter) Subtract(n int) {
-	c.value -= n
+// isFloat сообщает, является ли T вещественным типом
+func isFloat[T Number]() bool {
+	var one T = 1
+	return one/2 != 0
 }
@@ -0,0 +1,124 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoHistory возвращается Undo, если отменять нечего
+var ErrNoHistory = errors.New("counter history is empty")
+
+// CounterOp — вид изменения счетчика
+type CounterOp int
+
+const (
+	// OpIncrement — вызов Increment
+	OpIncrement CounterOp = iota
+	// OpDecrement — вызов Decrement
+	OpDecrement
+	// OpAdd — вызов Add
+	OpAdd
+	// OpSubtract — вызов Subtract
+	OpSubtract
+	// OpReset — вызов Reset
+	OpReset
+)
+
+// String возвращает название операции
+func (op CounterOp) String() string {
+	switch op {
+	case OpIncrement:
+		return "Increment"
+	case OpDecrement:
+		return "Decrement"
+	case OpAdd:
+		return "Add"
+	case OpSubtract:
+		return "Subtract"
+	case OpReset:
+		return "Reset"
+	default:
+		return "Unknown"
+	}
+}
+
+// Mutation — запись об одном изменении счетчика
+type Mutation[T Number] struct {
+	// Op — вид изменения
+	Op CounterOp
+	// Delta — величина изменения; для Increment и Decrement это шаг, для Reset — ноль
+	Delta T
+	// At — время изменения
+	At time.Time
+
+	// before — значение до изменения, по нему Undo восстанавливает счетчик
+	before T
+}
+
+// WithHistory включает запись успешных изменений счетчика в историю из не более чем limit последних записей.
+// Неположительный limit снимает ограничение
+func WithHistory[T Number](limit int) CounterOption[T] {
+	return func(c *Counter[T]) {
+		c.recordHistory = true
+		c.historyLimit = max(limit, 0)
+	}
+}
+
+// History возвращает копию истории изменений от старых к новым
+func (c *Counter[T]) History() []Mutation[T] {
+	return append([]Mutation[T](nil), c.history...)
+}
+
+// Undo отменяет последнее записанное изменение, возвращая счетчику прежнее значение.
+// Сама отмена в историю не записывается
+func (c *Counter[T]) Undo() error {
+	if len(c.history) == 0 {
+		return ErrNoHistory
+	}
+	last := c.history[len(c.history)-1]
+	c.history = c.history[:len(c.history)-1]
+
+	old := c.value
+	c.value = last.before
+	c.checkThresholds(old)
+	return nil
+}
+
+// Replay по очереди применяет к счетчику изменения из history, например чтобы восстановить
+// состояние на новом счетчике. Increment и Decrement повторяются с записанным шагом, а не с шагом c.
+// Каждое изменение попадает в историю c с той же операцией, поэтому историю можно переигрывать дальше
+func (c *Counter[T]) Replay(history []Mutation[T]) error {
+	for i, m := range history {
+		var err error
+		switch m.Op {
+		case OpIncrement:
+			err = c.apply(OpIncrement, m.Delta, c.add)
+		case OpDecrement:
+			err = c.apply(OpDecrement, m.Delta, c.sub)
+		case OpAdd:
+			err = c.Add(m.Delta)
+		case OpSubtract:
+			err = c.Subtract(m.Delta)
+		case OpReset:
+			c.Reset()
+		default:
+			err = fmt.Errorf("unknown operation %d", m.Op)
+		}
+		if err != nil {
+			return fmt.Errorf("replay mutation %d (%s): %w", i, m.Op, err)
+		}
+	}
+	return nil
+}
+
+// record добавляет изменение в историю, вытесняя самые старые записи сверх лимита
+func (c *Counter[T]) record(op CounterOp, delta, before T) {
+	if !c.recordHistory {
+		return
+	}
+	c.history = append(c.history, Mutation[T]{Op: op, Delta: delta, At: time.Now(), before: before})
+	if c.historyLimit > 0 && len(c.history) > c.historyLimit {
+		c.history = c.history[len(c.history)-c.historyLimit:]
+	}
+}
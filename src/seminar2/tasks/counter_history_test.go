@@ -0,0 +1,170 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterHistory(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounterWithStep(2, WithHistory[int](0))
+	cnt.Increment()
+	cnt.Add(5)
+	cnt.Decrement()
+	cnt.Subtract(1)
+	cnt.Reset()
+
+	history := cnt.History()
+	assert.Len(t, history, 5)
+
+	ops := make([]CounterOp, len(history))
+	deltas := make([]int, len(history))
+	for i, m := range history {
+		ops[i] = m.Op
+		deltas[i] = m.Delta
+		assert.False(t, m.At.IsZero())
+	}
+	assert.Equal(t, []CounterOp{OpIncrement, OpAdd, OpDecrement, OpSubtract, OpReset}, ops)
+	assert.Equal(t, []int{2, 5, 2, 1, 0}, deltas)
+}
+
+func TestCounterHistoryDisabled(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter(0)
+	cnt.Increment()
+
+	assert.Empty(t, cnt.History())
+	assert.ErrorIs(t, cnt.Undo(), ErrNoHistory)
+	assert.Equal(t, 1, cnt.GetValue())
+}
+
+func TestCounterHistoryLimit(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter(0, WithHistory[int](3))
+	for i := 1; i <= 5; i++ {
+		cnt.Add(i)
+	}
+
+	history := cnt.History()
+	assert.Len(t, history, 3)
+	assert.Equal(t, 3, history[0].Delta)
+	assert.Equal(t, 5, history[2].Delta)
+}
+
+func TestCounterUndo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		actions  func(*Counter[int])
+		undos    int
+		expected int
+	}{
+		{
+			name: "undo last add",
+			actions: func(c *Counter[int]) {
+				c.Add(5)
+				c.Add(10)
+			},
+			undos:    1,
+			expected: 5,
+		},
+		{
+			name: "undo reset",
+			actions: func(c *Counter[int]) {
+				c.Add(7)
+				c.Reset()
+			},
+			undos:    1,
+			expected: 7,
+		},
+		{
+			name: "undo everything",
+			actions: func(c *Counter[int]) {
+				c.Increment()
+				c.Subtract(4)
+				c.Decrement()
+			},
+			undos:    3,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := NewCounter(0, WithHistory[int](0))
+			tt.actions(cnt)
+			for range tt.undos {
+				assert.NoError(t, cnt.Undo())
+			}
+			assert.Equal(t, tt.expected, cnt.GetValue())
+		})
+	}
+}
+
+func TestCounterUndoRestoresClampedValue(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter(8, WithBounds(0, 10, BoundClamp), WithHistory[int](0))
+	cnt.Add(5)
+	assert.Equal(t, 10, cnt.GetValue())
+
+	assert.NoError(t, cnt.Undo())
+	assert.Equal(t, 8, cnt.GetValue())
+}
+
+func TestCounterHistorySkipsFailedMutations(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter(0, WithBounds(0, 10, BoundError), WithHistory[int](0))
+	assert.Error(t, cnt.Decrement())
+	assert.Empty(t, cnt.History())
+}
+
+func TestCounterReplay(t *testing.T) {
+	t.Parallel()
+
+	source := NewCounterWithStep(3, WithHistory[int](0))
+	source.Increment()
+	source.Add(10)
+	source.Reset()
+	source.Decrement()
+	source.Subtract(2)
+
+	replica := NewCounter(0)
+	assert.NoError(t, replica.Replay(source.History()))
+	assert.Equal(t, source.GetValue(), replica.GetValue())
+
+	roundTrip := NewCounter(0, WithHistory[int](0))
+	assert.NoError(t, roundTrip.Replay(source.History()))
+	assert.Equal(t, ops(source.History()), ops(roundTrip.History()))
+	assert.Equal(t, deltas(source.History()), deltas(roundTrip.History()))
+
+	again := NewCounter(0)
+	assert.NoError(t, again.Replay(roundTrip.History()))
+	assert.Equal(t, source.GetValue(), again.GetValue())
+
+	bounded := NewCounter(0, WithBounds(-1, 100, BoundError))
+	assert.ErrorIs(t, bounded.Replay(source.History()), ErrCounterUnderflow)
+}
+
+func ops[T Number](history []Mutation[T]) []CounterOp {
+	return Map(history, func(m Mutation[T]) CounterOp { return m.Op })
+}
+
+func deltas[T Number](history []Mutation[T]) []T {
+	return Map(history, func(m Mutation[T]) T { return m.Delta })
+}
+
+func TestCounterOpString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Increment", OpIncrement.String())
+	assert.Equal(t, "Reset", OpReset.String())
+	assert.Equal(t, "Unknown", CounterOp(42).String())
+}
@@ -0,0 +1,19 @@
+package tasks
+
+import "encoding/json"
+
+// MarshalJSON кодирует счетчик как его текущее значение
+func (c *Counter[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.value)
+}
+
+// UnmarshalJSON устанавливает значение счетчика из числа в JSON, приводя его в границы.
+// Шаг, границы и прочие настройки сохраняются, в историю загрузка не записывается
+func (c *Counter[T]) UnmarshalJSON(data []byte) error {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	c.value = c.normalize(value)
+	return nil
+}
@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		counter  *Counter[float64]
+		input    string
+		expected float64
+	}{
+		{"plain value", NewCounter(0.0), "2.5", 2.5},
+		{"clamped into bounds", NewCounter(0.0, WithBounds(0.0, 10.0, BoundClamp)), "42", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.NoError(t, json.Unmarshal([]byte(tt.input), tt.counter))
+			assert.Equal(t, tt.expected, tt.counter.GetValue())
+		})
+	}
+}
+
+func TestCounterJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	type metrics struct {
+		Requests *Counter[int] `json:"requests"`
+	}
+
+	data, err := json.Marshal(metrics{Requests: NewCounter(7)})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"requests": 7}`, string(data))
+
+	decoded := metrics{Requests: NewCounter(0)}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, 7, decoded.Requests.GetValue())
+}
+
+func TestCounterJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter(3)
+	assert.Error(t, json.Unmarshal([]byte(`"three"`), cnt))
+	assert.Equal(t, 3, cnt.GetValue())
+}
@@ -0,0 +1,21 @@
+package tasks
+
+// Merge прибавляет к счетчику значение other, например при сборе результатов нескольких воркеров.
+// Изменение проходит через Add, поэтому учитывает границы, историю и пороги
+func (c *Counter[T]) Merge(other *Counter[T]) error {
+	return c.Add(other.value)
+}
+
+// Diff возвращает разность значений счетчика и other
+func (c *Counter[T]) Diff(other *Counter[T]) T {
+	return c.value - other.value
+}
+
+// MergeAll возвращает новый счетчик без ограничений, значение которого равно сумме значений counters
+func MergeAll[T Number](counters []*Counter[T]) *Counter[T] {
+	var total T
+	for _, c := range counters {
+		total += c.value
+	}
+	return NewCounter(total)
+}
@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterMerge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		counter  *Counter[int]
+		other    *Counter[int]
+		expected int
+		err      error
+	}{
+		{"simple merge", NewCounter(3), NewCounter(4), 7, nil},
+		{"merge negative", NewCounter(3), NewCounter(-5), -2, nil},
+		{"merge respects bounds", NewCounter(3, WithBounds(0, 5, BoundClamp)), NewCounter(4), 5, nil},
+		{"merge overflow", NewCounter(3, WithBounds(0, 5, BoundError)), NewCounter(4), 3, ErrCounterOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.counter.Merge(tt.other)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, tt.counter.GetValue())
+		})
+	}
+}
+
+func TestCounterDiff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 6, NewCounter(10).Diff(NewCounter(4)))
+	assert.Equal(t, -6, NewCounter(4).Diff(NewCounter(10)))
+	assert.InDelta(t, 0.5, NewCounter(1.5).Diff(NewCounter(1.0)), 1e-9)
+}
+
+func TestMergeAll(t *testing.T) {
+	t.Parallel()
+
+	// Каждый воркер считает свою часть, затем результаты сводятся
+	workers := make([]*Counter[int], 4)
+	var wg sync.WaitGroup
+	for i := range workers {
+		workers[i] = NewCounter(0)
+		wg.Add(1)
+		go func(c *Counter[int]) {
+			defer wg.Done()
+			for range 250 {
+				c.Increment()
+			}
+		}(workers[i])
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1000, MergeAll(workers).GetValue())
+	assert.Equal(t, 0, MergeAll[int](nil).GetValue())
+}
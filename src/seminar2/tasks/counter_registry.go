@@ -0,0 +1,59 @@
+package tasks
+
+import (
+	"strings"
+	"sync"
+)
+
+// CounterRegistry хранит именованные потокобезопасные счетчики.
+// Все методы можно вызывать из нескольких горутин
+type CounterRegistry struct {
+	mu       sync.RWMutex
+	counters map[string]*AtomicCounter
+}
+
+// NewCounterRegistry создает пустой реестр счетчиков
+func NewCounterRegistry() *CounterRegistry {
+	return &CounterRegistry{counters: make(map[string]*AtomicCounter)}
+}
+
+// Get возвращает счетчик с именем name, создавая его при первом обращении
+func (r *CounterRegistry) Get(name string) *AtomicCounter {
+	r.mu.RLock()
+	counter, ok := r.counters[name]
+	r.mu.RUnlock()
+	if ok {
+		return counter
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Счетчик мог создать другой поток, пока блокировка была отпущена
+	if counter, ok = r.counters[name]; !ok {
+		counter = &AtomicCounter{}
+		r.counters[name] = counter
+	}
+	return counter
+}
+
+// IncrementAll увеличивает на 1 все существующие счетчики, имена которых начинаются с prefix
+func (r *CounterRegistry) IncrementAll(prefix string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for name, counter := range r.counters {
+		if strings.HasPrefix(name, prefix) {
+			counter.Increment()
+		}
+	}
+}
+
+// Snapshot возвращает копию текущих значений всех счетчиков
+func (r *CounterRegistry) Snapshot() map[string]int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	snapshot := make(map[string]int, len(r.counters))
+	for name, counter := range r.counters {
+		snapshot[name] = counter.GetValue()
+	}
+	return snapshot
+}
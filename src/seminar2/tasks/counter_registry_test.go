@@ -0,0 +1,71 @@
+package tasks
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterRegistry(t *testing.T) {
+	t.Parallel()
+
+	r := NewCounterRegistry()
+	r.Get("http.requests").Add(3)
+	r.Get("http.errors").Increment()
+	r.Get("db.queries").Add(10)
+
+	assert.Same(t, r.Get("http.requests"), r.Get("http.requests"))
+
+	r.IncrementAll("http.")
+	assert.Equal(t, map[string]int{
+		"http.requests": 4,
+		"http.errors":   2,
+		"db.queries":    10,
+	}, r.Snapshot())
+
+	r.IncrementAll("")
+	assert.Equal(t, 11, r.Get("db.queries").GetValue())
+}
+
+func TestCounterRegistrySnapshotIsCopy(t *testing.T) {
+	t.Parallel()
+
+	r := NewCounterRegistry()
+	r.Get("a").Increment()
+
+	snapshot := r.Snapshot()
+	r.Get("a").Increment()
+	r.Get("b").Increment()
+
+	assert.Equal(t, map[string]int{"a": 1}, snapshot)
+	assert.Empty(t, NewCounterRegistry().Snapshot())
+}
+
+func TestCounterRegistryConcurrent(t *testing.T) {
+	t.Parallel()
+
+	r := NewCounterRegistry()
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				r.Get(fmt.Sprintf("worker.%d", j%5)).Increment()
+				if i%10 == 0 {
+					r.IncrementAll("none.")
+					r.Snapshot()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	snapshot := r.Snapshot()
+	assert.Len(t, snapshot, 5)
+	for _, value := range snapshot {
+		assert.Equal(t, 50*20, value)
+	}
+}
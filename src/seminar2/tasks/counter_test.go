@@ -1,7 +1,9 @@
 package tasks
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -10,19 +12,19 @@ func TestCounter(t *testing.T) {
 	tests := []struct {
 		name     string
 		initial  int
-		actions  func(*counter)
+		actions  func(*Counter[int])
 		expected int
 	}{
 		{
 			name:     "initial value",
 			initial:  0,
-			actions:  func(c *counter) {},
+			actions:  func(c *Counter[int]) {},
 			expected: 0,
 		},
 		{
 			name:    "increment once",
 			initial: 0,
-			actions: func(c *counter) {
+			actions: func(c *Counter[int]) {
 				c.Increment()
 			},
 			expected: 1,
@@ -30,7 +32,7 @@ func TestCounter(t *testing.T) {
 		{
 			name:    "decrement once",
 			initial: 5,
-			actions: func(c *counter) {
+			actions: func(c *Counter[int]) {
 				c.Decrement()
 			},
 			expected: 4,
@@ -38,7 +40,7 @@ func TestCounter(t *testing.T) {
 		{
 			name:    "reset counter",
 			initial: 10,
-			actions: func(c *counter) {
+			actions: func(c *Counter[int]) {
 				c.Reset()
 			},
 			expected: 0,
@@ -46,7 +48,7 @@ func TestCounter(t *testing.T) {
 		{
 			name:    "add number",
 			initial: 5,
-			actions: func(c *counter) {
+			actions: func(c *Counter[int]) {
 				c.Add(10)
 			},
 			expected: 15,
@@ -54,7 +56,7 @@ func TestCounter(t *testing.T) {
 		{
 			name:    "subtract number",
 			initial: 20,
-			actions: func(c *counter) {
+			actions: func(c *Counter[int]) {
 				c.Subtract(7)
 			},
 			expected: 13,
@@ -62,7 +64,7 @@ func TestCounter(t *testing.T) {
 		{
 			name:    "multiple operations",
 			initial: 0,
-			actions: func(c *counter) {
+			actions: func(c *Counter[int]) {
 				c.Increment()
 				c.Increment()
 				c.Add(5)
@@ -75,10 +77,440 @@ func TestCounter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cnt := newCounter(tt.initial)
+			cnt := NewCounter(tt.initial)
 			tt.actions(cnt)
 
 			assert.Equal(t, tt.expected, cnt.GetValue(), "Counter value should match expected")
 		})
 	}
 }
+
+func TestNewCounterWithStep(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		step     int
+		actions  func(*Counter[int])
+		expected int
+	}{
+		{
+			name:     "starts at zero",
+			step:     5,
+			actions:  func(c *Counter[int]) {},
+			expected: 0,
+		},
+		{
+			name: "increment by step",
+			step: 5,
+			actions: func(c *Counter[int]) {
+				c.Increment()
+				c.Increment()
+			},
+			expected: 10,
+		},
+		{
+			name: "decrement by step",
+			step: 3,
+			actions: func(c *Counter[int]) {
+				c.Decrement()
+			},
+			expected: -3,
+		},
+		{
+			name: "add ignores step",
+			step: 10,
+			actions: func(c *Counter[int]) {
+				c.Add(1)
+				c.Subtract(2)
+			},
+			expected: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := NewCounterWithStep(tt.step)
+			tt.actions(cnt)
+			assert.Equal(t, tt.expected, cnt.GetValue())
+		})
+	}
+}
+
+func TestCounterGeneric(t *testing.T) {
+	t.Parallel()
+
+	t.Run("float64", func(t *testing.T) {
+		t.Parallel()
+		cnt := NewCounterWithStep(0.5)
+		cnt.Increment()
+		cnt.Add(1.25)
+		cnt.Decrement()
+		assert.InDelta(t, 1.25, cnt.GetValue(), 1e-9)
+	})
+
+	t.Run("uint64 bytes", func(t *testing.T) {
+		t.Parallel()
+		cnt := NewCounter[uint64](0)
+		cnt.Add(1 << 40)
+		cnt.Subtract(1 << 39)
+		assert.Equal(t, uint64(1<<39), cnt.GetValue())
+	})
+
+	t.Run("durations", func(t *testing.T) {
+		t.Parallel()
+		cnt := NewCounterWithStep(time.Second)
+		cnt.Increment()
+		cnt.Add(500 * time.Millisecond)
+		assert.Equal(t, 1500*time.Millisecond, cnt.GetValue())
+	})
+}
+
+func TestCounterBounds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		counter  func() *Counter[int]
+		actions  func(*Counter[int]) error
+		expected int
+		err      error
+	}{
+		{
+			name:    "clamp at maximum",
+			counter: func() *Counter[int] { return NewCounter(8, WithBounds(0, 10, BoundClamp)) },
+			actions: func(c *Counter[int]) error {
+				return c.Add(5)
+			},
+			expected: 10,
+		},
+		{
+			name:    "clamp floor at zero",
+			counter: func() *Counter[int] { return NewCounter(1, WithBounds(0, 10, BoundClamp)) },
+			actions: func(c *Counter[int]) error {
+				c.Decrement()
+				return c.Decrement()
+			},
+			expected: 0,
+		},
+		{
+			name:     "clamp initial value",
+			counter:  func() *Counter[int] { return NewCounter(42, WithBounds(0, 10, BoundClamp)) },
+			actions:  func(c *Counter[int]) error { return nil },
+			expected: 10,
+		},
+		{
+			name:    "wrap around like a clock",
+			counter: func() *Counter[int] { return NewCounter(55, WithBounds(0, 60, BoundWrap)) },
+			actions: func(c *Counter[int]) error {
+				return c.Add(10)
+			},
+			expected: 5,
+		},
+		{
+			name:    "wrap below minimum",
+			counter: func() *Counter[int] { return NewCounter(2, WithBounds(0, 60, BoundWrap)) },
+			actions: func(c *Counter[int]) error {
+				return c.Subtract(125)
+			},
+			expected: 57,
+		},
+		{
+			name:    "wrap negative add",
+			counter: func() *Counter[int] { return NewCounter(0, WithBounds(0, 60, BoundWrap)) },
+			actions: func(c *Counter[int]) error {
+				return c.Add(-1)
+			},
+			expected: 59,
+		},
+		{
+			name:     "wrap initial value",
+			counter:  func() *Counter[int] { return NewCounter(-60, WithBounds(0, 60, BoundWrap)) },
+			actions:  func(c *Counter[int]) error { return nil },
+			expected: 0,
+		},
+		{
+			name:    "error on overflow keeps value",
+			counter: func() *Counter[int] { return NewCounter(9, WithBounds(0, 10, BoundError)) },
+			actions: func(c *Counter[int]) error {
+				return c.Add(2)
+			},
+			expected: 9,
+			err:      ErrCounterOverflow,
+		},
+		{
+			name:    "error on underflow keeps value",
+			counter: func() *Counter[int] { return NewCounter(0, WithBounds(0, 10, BoundError)) },
+			actions: func(c *Counter[int]) error {
+				return c.Decrement()
+			},
+			expected: 0,
+			err:      ErrCounterUnderflow,
+		},
+		{
+			name:    "reset brings zero into bounds",
+			counter: func() *Counter[int] { return NewCounter(7, WithBounds(5, 10, BoundClamp)) },
+			actions: func(c *Counter[int]) error {
+				c.Reset()
+				return nil
+			},
+			expected: 5,
+		},
+		{
+			name:    "invalid bounds are ignored",
+			counter: func() *Counter[int] { return NewCounter(0, WithBounds(10, 0, BoundClamp)) },
+			actions: func(c *Counter[int]) error {
+				return c.Subtract(100)
+			},
+			expected: -100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := tt.counter()
+			err := tt.actions(cnt)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, cnt.GetValue())
+		})
+	}
+}
+
+func TestCounterExtremes(t *testing.T) {
+	t.Parallel()
+
+	fullRange := func(v int64, mode BoundMode) func() *Counter[int64] {
+		return func() *Counter[int64] { return NewCounter(v, WithBounds[int64](math.MinInt64, math.MaxInt64, mode)) }
+	}
+	clock := func(v int64) func() *Counter[int64] {
+		return func() *Counter[int64] { return NewCounter(v, WithBounds[int64](0, 60, BoundWrap)) }
+	}
+
+	tests := []struct {
+		name     string
+		counter  func() *Counter[int64]
+		action   func(*Counter[int64]) error
+		expected int64
+		err      error
+	}{
+		{
+			name:     "unbounded add min int",
+			counter:  func() *Counter[int64] { return NewCounter[int64](5) },
+			action:   func(c *Counter[int64]) error { return c.Add(math.MinInt64) },
+			expected: math.MinInt64 + 5,
+		},
+		{
+			name:     "clamp add min int",
+			counter:  func() *Counter[int64] { return NewCounter[int64](5, WithBounds[int64](0, 10, BoundClamp)) },
+			action:   func(c *Counter[int64]) error { return c.Add(math.MinInt64) },
+			expected: 0,
+		},
+		{
+			name:     "clamp subtract min int",
+			counter:  func() *Counter[int64] { return NewCounter[int64](5, WithBounds[int64](0, 10, BoundClamp)) },
+			action:   func(c *Counter[int64]) error { return c.Subtract(math.MinInt64) },
+			expected: 10,
+		},
+		{
+			name:     "error subtract min int",
+			counter:  func() *Counter[int64] { return NewCounter[int64](5, WithBounds[int64](0, 10, BoundError)) },
+			action:   func(c *Counter[int64]) error { return c.Subtract(math.MinInt64) },
+			expected: 5,
+			err:      ErrCounterOverflow,
+		},
+		{
+			name:     "full range add from minimum",
+			counter:  fullRange(math.MinInt64, BoundClamp),
+			action:   func(c *Counter[int64]) error { return c.Add(math.MaxInt64) },
+			expected: -1,
+		},
+		{
+			name:     "full range clamp at maximum",
+			counter:  fullRange(10, BoundClamp),
+			action:   func(c *Counter[int64]) error { return c.Add(math.MaxInt64) },
+			expected: math.MaxInt64,
+		},
+		{
+			name:     "full range clamp at minimum",
+			counter:  fullRange(-10, BoundClamp),
+			action:   func(c *Counter[int64]) error { return c.Subtract(math.MaxInt64) },
+			expected: math.MinInt64,
+		},
+		{
+			name:     "full range error on overflow",
+			counter:  fullRange(1, BoundError),
+			action:   func(c *Counter[int64]) error { return c.Add(math.MaxInt64) },
+			expected: 1,
+			err:      ErrCounterOverflow,
+		},
+		{
+			name:     "full range wrap past maximum",
+			counter:  fullRange(math.MaxInt64-1, BoundWrap),
+			action:   func(c *Counter[int64]) error { return c.Increment() },
+			expected: math.MinInt64,
+		},
+		{
+			name:     "full range wrap below minimum",
+			counter:  fullRange(math.MinInt64, BoundWrap),
+			action:   func(c *Counter[int64]) error { return c.Decrement() },
+			expected: math.MaxInt64 - 1,
+		},
+		{
+			name:     "full range wrap initial value",
+			counter:  fullRange(math.MaxInt64, BoundWrap),
+			action:   func(c *Counter[int64]) error { return nil },
+			expected: math.MinInt64,
+		},
+		{
+			name:     "wrap add min int",
+			counter:  clock(0),
+			action:   func(c *Counter[int64]) error { return c.Add(math.MinInt64) },
+			expected: 52,
+		},
+		{
+			name:     "wrap subtract min int",
+			counter:  clock(0),
+			action:   func(c *Counter[int64]) error { return c.Subtract(math.MinInt64) },
+			expected: 8,
+		},
+		{
+			name:     "wrap large initial value",
+			counter:  clock(math.MaxInt64),
+			action:   func(c *Counter[int64]) error { return nil },
+			expected: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := tt.counter()
+			err := tt.action(cnt)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, cnt.GetValue())
+		})
+	}
+}
+
+func TestCounterBoundsUnsigned(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter[uint](3, WithBounds[uint](0, 100, BoundClamp))
+	assert.NoError(t, cnt.Subtract(10))
+	assert.Equal(t, uint(0), cnt.GetValue())
+
+	wrapped := NewCounter[uint8](250, WithBounds[uint8](0, 255, BoundWrap))
+	assert.NoError(t, wrapped.Add(10))
+	assert.Equal(t, uint8(5), wrapped.GetValue())
+}
+
+func TestCounterBoundsFloat(t *testing.T) {
+	t.Parallel()
+
+	angle := NewCounter(350.0, WithBounds(0.0, 360.0, BoundWrap))
+	assert.NoError(t, angle.Add(20.5))
+	assert.InDelta(t, 10.5, angle.GetValue(), 1e-9)
+}
+
+func TestCounterOnThreshold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		initial  int
+		level    int
+		actions  func(*Counter[int])
+		expected int
+	}{
+		{
+			name:    "fires when reaching the level",
+			initial: 0,
+			level:   3,
+			actions: func(c *Counter[int]) {
+				c.Increment()
+				c.Increment()
+				c.Increment()
+				c.Increment()
+			},
+			expected: 1,
+		},
+		{
+			name:    "fires when jumping over the level",
+			initial: 0,
+			level:   3,
+			actions: func(c *Counter[int]) {
+				c.Add(10)
+			},
+			expected: 1,
+		},
+		{
+			name:    "fires on the way down",
+			initial: 5,
+			level:   3,
+			actions: func(c *Counter[int]) {
+				c.Subtract(1)
+				c.Subtract(1)
+				c.Subtract(1)
+			},
+			expected: 1,
+		},
+		{
+			name:    "fires on every crossing",
+			initial: 0,
+			level:   1,
+			actions: func(c *Counter[int]) {
+				c.Increment()
+				c.Decrement()
+				c.Increment()
+				c.Reset()
+			},
+			expected: 4,
+		},
+		{
+			name:    "does not fire without crossing",
+			initial: 5,
+			level:   3,
+			actions: func(c *Counter[int]) {
+				c.Add(10)
+				c.Subtract(12)
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cnt := NewCounter(tt.initial)
+			fired := 0
+			cnt.OnThreshold(tt.level, func() { fired++ })
+			tt.actions(cnt)
+			assert.Equal(t, tt.expected, fired)
+		})
+	}
+}
+
+func TestCounterOnThresholdMultiple(t *testing.T) {
+	t.Parallel()
+
+	cnt := NewCounter(0)
+	var fired []string
+	cnt.OnThreshold(5, func() { fired = append(fired, "warning") })
+	cnt.OnThreshold(10, func() { fired = append(fired, "critical") })
+
+	cnt.Add(7)
+	cnt.Add(7)
+	cnt.Subtract(14)
+
+	assert.Equal(t, []string{"warning", "critical", "warning", "critical"}, fired)
+}
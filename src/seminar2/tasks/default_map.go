@@ -0,0 +1,65 @@
+package tasks
+
+import "iter"
+
+// DefaultMap — хеш-таблица, которая при обращении к отсутствующему ключу создает для него значение
+// функцией factory и сохраняет его (аналог defaultdict из Python)
+type DefaultMap[K comparable, V any] struct {
+	items   map[K]V
+	factory func(K) V
+}
+
+// NewDefaultMap создает пустую таблицу со значениями по умолчанию от factory
+func NewDefaultMap[K comparable, V any](factory func(K) V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{items: make(map[K]V), factory: factory}
+}
+
+// Get возвращает значение по ключу, создавая и сохраняя его, если ключа нет
+func (m *DefaultMap[K, V]) Get(key K) V {
+	v, ok := m.items[key]
+	if !ok {
+		v = m.factory(key)
+		m.items[key] = v
+	}
+	return v
+}
+
+// Lookup возвращает значение и признак наличия ключа, не создавая значение по умолчанию
+func (m *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	v, ok := m.items[key]
+	return v, ok
+}
+
+// Set записывает значение по ключу
+func (m *DefaultMap[K, V]) Set(key K, value V) {
+	m.items[key] = value
+}
+
+// Update заменяет значение ключа на f(старое значение), начиная со значения по умолчанию.
+// Удобно для значений-слайсов: m.Update(k, func(v []T) []T { return append(v, x) })
+func (m *DefaultMap[K, V]) Update(key K, f func(V) V) {
+	m.items[key] = f(m.Get(key))
+}
+
+// Delete удаляет ключ и сообщает, был ли он в таблице
+func (m *DefaultMap[K, V]) Delete(key K) bool {
+	_, ok := m.items[key]
+	delete(m.items, key)
+	return ok
+}
+
+// Len возвращает количество ключей
+func (m *DefaultMap[K, V]) Len() int {
+	return len(m.items)
+}
+
+// All перечисляет пары в произвольном порядке
+func (m *DefaultMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.items {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package tasks
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultMapGet(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	m := NewDefaultMap(func(k string) int {
+		calls++
+		return len(k)
+	})
+
+	assert.Equal(t, 5, m.Get("hello"))
+	assert.Equal(t, 5, m.Get("hello"))
+	assert.Equal(t, 1, calls, "the default is created once and stored")
+	assert.Equal(t, 1, m.Len())
+
+	_, ok := m.Lookup("absent")
+	assert.False(t, ok)
+	assert.Equal(t, 1, m.Len(), "Lookup does not create values")
+
+	m.Set("hello", 42)
+	v, ok := m.Lookup("hello")
+	assert.True(t, ok)
+	assert.Equal(t, 42, v)
+
+	assert.True(t, m.Delete("hello"))
+	assert.False(t, m.Delete("hello"))
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestDefaultMapGrouping(t *testing.T) {
+	t.Parallel()
+
+	groups := NewDefaultMap(func(int) []string { return nil })
+	for _, word := range []string{"go", "rust", "c", "java", "zig"} {
+		groups.Update(len(word), func(ws []string) []string { return append(ws, word) })
+	}
+
+	assert.Equal(t, map[int][]string{
+		1: {"c"},
+		2: {"go"},
+		3: {"zig"},
+		4: {"rust", "java"},
+	}, maps.Collect(groups.All()))
+}
+
+func TestDefaultMapCounting(t *testing.T) {
+	t.Parallel()
+
+	counts := NewDefaultMap(func(rune) int { return 0 })
+	for _, r := range "banana" {
+		counts.Update(r, func(n int) int { return n + 1 })
+	}
+
+	assert.Equal(t, map[rune]int{'b': 1, 'a': 3, 'n': 2}, maps.Collect(counts.All()))
+}
@@ -0,0 +1,29 @@
+package tasks
+
+import "iter"
+
+// Flatten склеивает слайсы xss в один, выделяя память под результат один раз
+func Flatten[T any](xss [][]T) []T {
+	total := 0
+	for _, xs := range xss {
+		total += len(xs)
+	}
+	result := make([]T, 0, total)
+	for _, xs := range xss {
+		result = append(result, xs...)
+	}
+	return result
+}
+
+// FlattenSeq лениво перечисляет элементы всех слайсов, выдаваемых seq
+func FlattenSeq[T any](seq iter.Seq[[]T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for xs := range seq {
+			for _, x := range xs {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    [][]int
+		expected []int
+	}{
+		{"several slices", [][]int{{1, 2}, {}, {3}, {4, 5}}, []int{1, 2, 3, 4, 5}},
+		{"empty input", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result := Flatten(tt.input)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, len(result), cap(result), "allocated exactly once")
+		})
+	}
+}
+
+func TestFlattenAfterChunk(t *testing.T) {
+	t.Parallel()
+
+	xs := Range(0, 10, 1)
+	assert.Equal(t, xs, Flatten(Chunk(xs, 3)))
+}
+
+func TestFlattenSeq(t *testing.T) {
+	t.Parallel()
+
+	words := FlattenSeq(slices.Values([][]string{{"a", "b"}, nil, {"c"}}))
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(words))
+
+	var first []int
+	for x := range FlattenSeq(CartesianProduct([]int{1, 2}, []int{3, 4})) {
+		if len(first) == 3 {
+			break
+		}
+		first = append(first, x)
+	}
+	assert.Equal(t, []int{1, 3, 1}, first)
+}
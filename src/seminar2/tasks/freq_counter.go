@@ -0,0 +1,114 @@
+package tasks
+
+import "slices"
+
+// ItemCount — элемент и количество его вхождений
+type ItemCount[T comparable] struct {
+	Item  T
+	Count int
+}
+
+// FreqCounter — мультимножество, считающее вхождения элементов (аналог collections.Counter из Python).
+// Элементы перечисляются в порядке первого добавления
+type FreqCounter[T comparable] struct {
+	counts map[T]int
+	order  []T
+}
+
+// NewFreqCounter создает счетчик и добавляет в него items
+func NewFreqCounter[T comparable](items ...T) *FreqCounter[T] {
+	c := &FreqCounter[T]{counts: make(map[T]int)}
+	for _, item := range items {
+		c.Add(item)
+	}
+	return c
+}
+
+// Add добавляет одно вхождение item
+func (c *FreqCounter[T]) Add(item T) {
+	c.addN(item, 1)
+}
+
+// Count возвращает количество вхождений item
+func (c *FreqCounter[T]) Count(item T) int {
+	return c.counts[item]
+}
+
+// Len возвращает количество различных элементов
+func (c *FreqCounter[T]) Len() int {
+	return len(c.order)
+}
+
+// Total возвращает суммарное количество вхождений всех элементов
+func (c *FreqCounter[T]) Total() int {
+	total := 0
+	for _, count := range c.counts {
+		total += count
+	}
+	return total
+}
+
+// MostCommon возвращает n самых частых элементов по убыванию количества;
+// при равенстве раньше идет элемент, добавленный первым. Если n < 0, возвращаются все элементы
+func (c *FreqCounter[T]) MostCommon(n int) []ItemCount[T] {
+	result := make([]ItemCount[T], 0, len(c.order))
+	for _, item := range c.order {
+		result = append(result, ItemCount[T]{Item: item, Count: c.counts[item]})
+	}
+	slices.SortStableFunc(result, func(a, b ItemCount[T]) int {
+		return b.Count - a.Count
+	})
+	if n >= 0 && n < len(result) {
+		result = result[:n]
+	}
+	return result
+}
+
+// Elements возвращает все элементы, каждый повторенный столько раз, сколько он встречался
+func (c *FreqCounter[T]) Elements() []T {
+	result := make([]T, 0, c.Total())
+	for _, item := range c.order {
+		for range c.counts[item] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Union возвращает счетчик, в котором у каждого элемента максимум из двух количеств
+func (c *FreqCounter[T]) Union(other *FreqCounter[T]) *FreqCounter[T] {
+	return c.combine(other, func(a, b int) int { return max(a, b) })
+}
+
+// Intersection возвращает счетчик, в котором у каждого элемента минимум из двух количеств
+func (c *FreqCounter[T]) Intersection(other *FreqCounter[T]) *FreqCounter[T] {
+	return c.combine(other, func(a, b int) int { return min(a, b) })
+}
+
+// Subtract возвращает счетчик с разностью количеств; элементы с неположительным результатом отбрасываются
+func (c *FreqCounter[T]) Subtract(other *FreqCounter[T]) *FreqCounter[T] {
+	return c.combine(other, func(a, b int) int { return a - b })
+}
+
+// combine строит новый счетчик, вычисляя количество каждого элемента из обоих счетчиков через f
+func (c *FreqCounter[T]) combine(other *FreqCounter[T], f func(a, b int) int) *FreqCounter[T] {
+	result := NewFreqCounter[T]()
+	for _, items := range [][]T{c.order, other.order} {
+		for _, item := range items {
+			if _, done := result.counts[item]; done {
+				continue
+			}
+			if count := f(c.counts[item], other.counts[item]); count > 0 {
+				result.addN(item, count)
+			}
+		}
+	}
+	return result
+}
+
+func (c *FreqCounter[T]) addN(item T, n int) {
+	if _, ok := c.counts[item]; !ok {
+		c.order = append(c.order, item)
+	}
+	c.counts[item] += n
+}
@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreqCounter(t *testing.T) {
+	t.Parallel()
+
+	words := strings.Fields("the cat and the dog and the bird")
+	c := NewFreqCounter(words...)
+
+	assert.Equal(t, 3, c.Count("the"))
+	assert.Equal(t, 2, c.Count("and"))
+	assert.Equal(t, 0, c.Count("fish"))
+	assert.Equal(t, 5, c.Len())
+	assert.Equal(t, 8, c.Total())
+
+	c.Add("fish")
+	assert.Equal(t, 1, c.Count("fish"))
+}
+
+func TestFreqCounterMostCommon(t *testing.T) {
+	t.Parallel()
+
+	c := NewFreqCounter(strings.Split("abracadabra", "")...)
+
+	tests := []struct {
+		name     string
+		n        int
+		expected []ItemCount[string]
+	}{
+		{"top one", 1, []ItemCount[string]{{"a", 5}}},
+		{"ties keep insertion order", 3, []ItemCount[string]{{"a", 5}, {"b", 2}, {"r", 2}}},
+		{"n larger than size", 10, []ItemCount[string]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}},
+		{"negative n returns all", -1, []ItemCount[string]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}},
+		{"zero", 0, []ItemCount[string]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, c.MostCommon(tt.n))
+		})
+	}
+}
+
+func TestFreqCounterElements(t *testing.T) {
+	t.Parallel()
+
+	c := NewFreqCounter("b", "a", "b")
+	assert.Equal(t, []string{"b", "b", "a"}, c.Elements())
+	assert.Empty(t, NewFreqCounter[int]().Elements())
+}
+
+func TestFreqCounterSetOperations(t *testing.T) {
+	t.Parallel()
+
+	a := NewFreqCounter("x", "x", "x", "y")
+	b := NewFreqCounter("x", "y", "y", "z")
+
+	tests := []struct {
+		name     string
+		result   *FreqCounter[string]
+		expected []string
+	}{
+		{"union", a.Union(b), []string{"x", "x", "x", "y", "y", "z"}},
+		{"intersection", a.Intersection(b), []string{"x", "y"}},
+		{"subtract", a.Subtract(b), []string{"x", "x"}},
+		{"subtract reversed", b.Subtract(a), []string{"y", "z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.result.Elements())
+		})
+	}
+}
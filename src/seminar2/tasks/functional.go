@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Filter возвращает элементы xs, удовлетворяющие predicate, в исходном порядке
+func Filter[T any](xs []T, predicate func(T) bool) []T {
+	var result []T
+	for _, x := range xs {
+		if predicate(x) {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+// Map применяет f к каждому элементу xs
+func Map[T, U any](xs []T, f func(T) U) []U {
+	result := make([]U, len(xs))
+	for i, x := range xs {
+		result[i] = f(x)
+	}
+	return result
+}
+
+// Reduce сворачивает xs слева направо, начиная с init
+func Reduce[T, A any](xs []T, init A, f func(acc A, x T) A) A {
+	acc := init
+	for _, x := range xs {
+		acc = f(acc, x)
+	}
+	return acc
+}
+
+// FlatMap применяет f к каждому элементу xs и склеивает полученные слайсы
+func FlatMap[T, U any](xs []T, f func(T) []U) []U {
+	var result []U
+	for _, x := range xs {
+		result = append(result, f(x)...)
+	}
+	return result
+}
+
+// Find возвращает первый элемент xs, удовлетворяющий predicate, и true,
+// либо нулевое значение и false, если такого нет
+func Find[T any](xs []T, predicate func(T) bool) (T, bool) {
+	for _, x := range xs {
+		if predicate(x) {
+			return x, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// MapErr применяет f к каждому элементу xs и останавливается на первой ошибке,
+// возвращая ее вместе с индексом элемента
+func MapErr[T, U any](xs []T, f func(T) (U, error)) ([]U, error) {
+	result := make([]U, len(xs))
+	for i, x := range xs {
+		u, err := f(x)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		result[i] = u
+	}
+	return result, nil
+}
+
+// MapErrAll применяет f ко всем элементам xs, не останавливаясь на ошибках.
+// Возвращает результаты (на месте ошибок — нулевые значения) и все ошибки, объединенные errors.Join
+func MapErrAll[T, U any](xs []T, f func(T) (U, error)) ([]U, error) {
+	result := make([]U, len(xs))
+	var errs []error
+	for i, x := range xs {
+		u, err := f(x)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("element %d: %w", i, err))
+			continue
+		}
+		result[i] = u
+	}
+	return result, errors.Join(errs...)
+}
+
+// MapCtx применяет f к каждому элементу xs, проверяя отмену ctx перед каждым элементом
+func MapCtx[T, U any](ctx context.Context, xs []T, f func(context.Context, T) (U, error)) ([]U, error) {
+	result := make([]U, len(xs))
+	for i, x := range xs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		u, err := f(ctx, x)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		result[i] = u
+	}
+	return result, nil
+}
@@ -0,0 +1,183 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     []string
+		predicate func(string) bool
+		expected  []string
+	}{
+		{"non-empty strings", []string{"a", "", "b", ""}, func(s string) bool { return s != "" }, []string{"a", "b"}},
+		{"nothing matches", []string{"a", "b"}, func(s string) bool { return false }, nil},
+		{"empty input", nil, func(s string) bool { return true }, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Filter(tt.input, tt.predicate))
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []string
+	}{
+		{"numbers to strings", []int{1, 22, 333}, []string{"1", "22", "333"}},
+		{"empty input", []int{}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Map(tt.input, strconv.Itoa))
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	t.Parallel()
+
+	sum := Reduce([]float64{1.5, 2.5, 3}, 0.0, func(acc, x float64) float64 { return acc + x })
+	assert.InDelta(t, 7.0, sum, 1e-9)
+
+	joined := Reduce([]int{1, 2, 3}, "", func(acc string, x int) string { return acc + strconv.Itoa(x) })
+	assert.Equal(t, "123", joined)
+
+	assert.Equal(t, 42, Reduce(nil, 42, func(acc, x int) int { return acc + x }))
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Parallel()
+
+	words := FlatMap([]string{"a b", "", "c d e"}, strings.Fields)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, words)
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"ann", 17}, {"bob", 25}, {"eve", 30}}
+
+	found, ok := Find(users, func(u user) bool { return u.age >= 18 })
+	assert.True(t, ok)
+	assert.Equal(t, "bob", found.name)
+
+	_, ok = Find(users, func(u user) bool { return u.age > 100 })
+	assert.False(t, ok)
+}
+
+func TestMapErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []string
+		expected []int
+		wantErr  bool
+	}{
+		{"all valid", []string{"1", "2", "3"}, []int{1, 2, 3}, false},
+		{"stops at first error", []string{"1", "x", "y"}, nil, true},
+		{"empty input", []string{}, []int{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := MapErr(tt.input, strconv.Atoi)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, strconv.ErrSyntax)
+				assert.ErrorContains(t, err, "element 1")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMapErrStopsCalling(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	_, err := MapErr([]int{1, 2, 3}, func(x int) (int, error) {
+		calls++
+		if x == 2 {
+			return 0, errors.New("boom")
+		}
+		return x, nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestMapErrAll(t *testing.T) {
+	t.Parallel()
+
+	result, err := MapErrAll([]string{"1", "x", "3", "y"}, strconv.Atoi)
+	assert.Equal(t, []int{1, 0, 3, 0}, result)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.ErrorContains(t, err, "element 1")
+	assert.ErrorContains(t, err, "element 3")
+
+	result, err = MapErrAll([]string{"4"}, strconv.Atoi)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4}, result)
+}
+
+func TestMapCtx(t *testing.T) {
+	t.Parallel()
+
+	double := func(_ context.Context, x int) (int, error) { return x * 2, nil }
+
+	t.Run("completes", func(t *testing.T) {
+		t.Parallel()
+		result, err := MapCtx(context.Background(), []int{1, 2, 3}, double)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4, 6}, result)
+	})
+
+	t.Run("stops on cancellation", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		_, err := MapCtx(ctx, []int{1, 2, 3, 4}, func(_ context.Context, x int) (int, error) {
+			calls++
+			if x == 2 {
+				cancel()
+			}
+			return x, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("propagates errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := MapCtx(context.Background(), []int{1}, func(context.Context, int) (int, error) {
+			return 0, errors.New("boom")
+		})
+		assert.EqualError(t, err, "element 0: boom")
+	})
+}
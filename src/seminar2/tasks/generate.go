@@ -0,0 +1,66 @@
+package tasks
+
+import "iter"
+
+// Integer — целочисленные типы
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Range возвращает числа от start до stop (не включая) с шагом step, как range в Python.
+// Отрицательный step считает вниз; при нулевом step результат пуст
+func Range[T Integer](start, stop, step T) []T {
+	var result []T
+	for x := range RangeSeq(start, stop, step) {
+		result = append(result, x)
+	}
+	return result
+}
+
+// RangeSeq — ленивый вариант Range, не хранящий числа в памяти
+func RangeSeq[T Integer](start, stop, step T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		switch {
+		case step > 0:
+			for x := start; x < stop; {
+				if !yield(x) || stop-x <= step {
+					return
+				}
+				x += step
+			}
+		case step < 0:
+			for x := start; x > stop; {
+				if !yield(x) || x-stop <= -step {
+					return
+				}
+				x += step
+			}
+		}
+	}
+}
+
+// Repeat возвращает слайс из n копий value; для n <= 0 — пустой слайс
+func Repeat[T any](value T, n int) []T {
+	result := make([]T, max(n, 0))
+	for i := range result {
+		result[i] = value
+	}
+	return result
+}
+
+// Cycle бесконечно повторяет элементы xs по кругу; для пустого xs последовательность пуста
+func Cycle[T any](xs []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(xs) == 0 {
+			return
+		}
+		for {
+			for _, x := range xs {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		start    int
+		stop     int
+		step     int
+		expected []int
+	}{
+		{"simple", 0, 5, 1, []int{0, 1, 2, 3, 4}},
+		{"with step", 1, 10, 3, []int{1, 4, 7}},
+		{"counting down", 5, 0, -2, []int{5, 3, 1}},
+		{"empty when start reaches stop", 3, 3, 1, nil},
+		{"empty for wrong direction", 0, 5, -1, nil},
+		{"zero step", 0, 5, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Range(tt.start, tt.stop, tt.step))
+		})
+	}
+}
+
+func TestRangeNoOverflow(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []uint8{250, 253}, Range[uint8](250, 255, 3))
+	assert.Equal(t, []int8{125, 126}, Range[int8](125, math.MaxInt8, 1))
+	assert.Equal(t, []int8{-127}, Range[int8](-127, math.MinInt8, -1))
+}
+
+func TestRangeSeqIsLazy(t *testing.T) {
+	t.Parallel()
+
+	sum := 0
+	for x := range RangeSeq(0, math.MaxInt, 1) {
+		if x == 100 {
+			break
+		}
+		sum += x
+	}
+	assert.Equal(t, 4950, sum)
+}
+
+func TestRepeat(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"ab", "ab", "ab"}, Repeat("ab", 3))
+	assert.Empty(t, Repeat(1, 0))
+	assert.Empty(t, Repeat(1, -2))
+}
+
+func TestCycle(t *testing.T) {
+	t.Parallel()
+
+	var got []string
+	for x := range Cycle([]string{"r", "g", "b"}) {
+		if len(got) == 7 {
+			break
+		}
+		got = append(got, x)
+	}
+	assert.Equal(t, []string{"r", "g", "b", "r", "g", "b", "r"}, got)
+
+	for range Cycle[int](nil) {
+		t.Fatal("empty cycle must not yield")
+	}
+}
@@ -0,0 +1,46 @@
+package tasks
+
+// Partition разделяет xs на элементы, удовлетворяющие predicate, и остальные, сохраняя порядок
+func Partition[T any](xs []T, predicate func(T) bool) (matched, rest []T) {
+	for _, x := range xs {
+		if predicate(x) {
+			matched = append(matched, x)
+		} else {
+			rest = append(rest, x)
+		}
+	}
+	return matched, rest
+}
+
+// GroupBy группирует элементы xs по ключу key; внутри группы порядок исходный
+func GroupBy[T any, K comparable](xs []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, x := range xs {
+		k := key(x)
+		groups[k] = append(groups[k], x)
+	}
+	return groups
+}
+
+// KeyBy строит отображение из ключа в элемент; при совпадении ключей побеждает последний элемент
+func KeyBy[T any, K comparable](xs []T, key func(T) K) map[K]T {
+	result := make(map[K]T, len(xs))
+	for _, x := range xs {
+		result[key(x)] = x
+	}
+	return result
+}
+
+// CountBy считает, сколько элементов xs приходится на каждый ключ key
+func CountBy[T any, K comparable](xs []T, key func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, x := range xs {
+		counts[key(x)]++
+	}
+	return counts
+}
+
+// Frequencies считает количество вхождений каждого элемента xs
+func Frequencies[T comparable](xs []T) map[T]int {
+	return CountBy(xs, identity[T])
+}
@@ -0,0 +1,91 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   []int
+		matched []int
+		rest    []int
+	}{
+		{"mixed", []int{1, 2, 3, 4, 5}, []int{2, 4}, []int{1, 3, 5}},
+		{"all match", []int{2, 4}, []int{2, 4}, nil},
+		{"none match", []int{1, 3}, nil, []int{1, 3}},
+		{"empty input", nil, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			matched, rest := Partition(tt.input, func(x int) bool { return x%2 == 0 })
+			assert.Equal(t, tt.matched, matched)
+			assert.Equal(t, tt.rest, rest)
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"apple", "bob", "avocado", "cat", "banana"}
+	groups := GroupBy(words, func(s string) byte { return s[0] })
+
+	assert.Equal(t, map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"bob", "banana"},
+		'c': {"cat"},
+	}, groups)
+	assert.Empty(t, GroupBy(nil, func(s string) int { return len(s) }))
+}
+
+func TestKeyBy(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "ann"}, {2, "bob"}, {1, "ann v2"}}
+
+	byID := KeyBy(users, func(u user) int { return u.id })
+	assert.Equal(t, map[int]user{
+		1: {1, "ann v2"},
+		2: {2, "bob"},
+	}, byID)
+}
+
+func TestCountBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []string
+		expected map[int]int
+	}{
+		{"by length", []string{"go", "c", "rust", "js", "zig"}, map[int]int{1: 1, 2: 2, 3: 1, 4: 1}},
+		{"empty input", nil, map[int]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, CountBy(tt.input, func(s string) int { return len(s) }))
+		})
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, map[string]int{"to": 2, "be": 2, "or": 1, "not": 1},
+		Frequencies([]string{"to", "be", "or", "not", "to", "be"}))
+	assert.Equal(t, map[rune]int{'a': 3, 'b': 1, 'n': 2}, Frequencies([]rune("banana")))
+	assert.Empty(t, Frequencies([]int{}))
+}
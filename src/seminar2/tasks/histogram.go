@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// ErrBucketMismatch возвращается Merge, если у гистограмм разные границы корзин
+var ErrBucketMismatch = errors.New("histogram buckets do not match")
+
+// Bucket — корзина гистограммы: количество наблюдений не больше UpperBound и больше границы предыдущей корзины
+type Bucket struct {
+	UpperBound float64
+	Count      int
+}
+
+// Histogram распределяет наблюдения по корзинам и оценивает по ним перцентили
+type Histogram struct {
+	// bounds — верхние границы корзин по возрастанию; последняя корзина counts не ограничена сверху
+	bounds   []float64
+	counts   []int
+	total    int
+	sum      float64
+	min, max float64
+}
+
+// NewHistogram создает гистограмму с корзинами, ограниченными сверху bounds.
+// Границы сортируются, повторы отбрасываются; наблюдения больше всех границ попадают в корзину +Inf
+func NewHistogram(bounds []float64) *Histogram {
+	sorted := slices.Clone(bounds)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+	return &Histogram{
+		bounds: sorted,
+		counts: make([]int, len(sorted)+1),
+		min:    math.Inf(1),
+		max:    math.Inf(-1),
+	}
+}
+
+// LinearBuckets возвращает count границ start, start+width, start+2*width, ...
+func LinearBuckets(start, width float64, count int) []float64 {
+	bounds := make([]float64, max(count, 0))
+	for i := range bounds {
+		bounds[i] = start + float64(i)*width
+	}
+	return bounds
+}
+
+// ExponentialBuckets возвращает count границ start, start*factor, start*factor^2, ...
+func ExponentialBuckets(start, factor float64, count int) []float64 {
+	bounds := make([]float64, max(count, 0))
+	for i := range bounds {
+		bounds[i] = start * math.Pow(factor, float64(i))
+	}
+	return bounds
+}
+
+// Observe добавляет наблюдение value
+func (h *Histogram) Observe(value float64) {
+	i, _ := slices.BinarySearch(h.bounds, value)
+	h.counts[i]++
+	h.total++
+	h.sum += value
+	h.min = min(h.min, value)
+	h.max = max(h.max, value)
+}
+
+// Count возвращает количество наблюдений
+func (h *Histogram) Count() int {
+	return h.total
+}
+
+// Sum возвращает сумму наблюдений
+func (h *Histogram) Sum() float64 {
+	return h.sum
+}
+
+// Buckets возвращает корзины вместе с последней корзиной +Inf
+func (h *Histogram) Buckets() []Bucket {
+	buckets := make([]Bucket, len(h.counts))
+	for i, count := range h.counts {
+		upper := math.Inf(1)
+		if i < len(h.bounds) {
+			upper = h.bounds[i]
+		}
+		buckets[i] = Bucket{UpperBound: upper, Count: count}
+	}
+	return buckets
+}
+
+// Percentile оценивает p-й перцентиль (p от 0 до 100) линейной интерполяцией внутри корзины.
+// Крайние корзины ограничиваются минимальным и максимальным наблюдением.
+// Для пустой гистограммы возвращает NaN
+func (h *Histogram) Percentile(p float64) float64 {
+	if h.total == 0 {
+		return math.NaN()
+	}
+	rank := min(max(p, 0), 100) / 100 * float64(h.total)
+
+	seen := 0
+	for i, count := range h.counts {
+		if count == 0 || float64(seen+count) < rank {
+			seen += count
+			continue
+		}
+		lower, upper := h.min, h.max
+		if i > 0 {
+			lower = max(lower, h.bounds[i-1])
+		}
+		if i < len(h.bounds) {
+			upper = min(upper, h.bounds[i])
+		}
+		return lower + (upper-lower)*(rank-float64(seen))/float64(count)
+	}
+	return h.max
+}
+
+// Merge добавляет к гистограмме наблюдения other; границы корзин должны совпадать
+func (h *Histogram) Merge(other *Histogram) error {
+	if !slices.Equal(h.bounds, other.bounds) {
+		return ErrBucketMismatch
+	}
+	for i, count := range other.counts {
+		h.counts[i] += count
+	}
+	h.total += other.total
+	h.sum += other.sum
+	h.min = min(h.min, other.min)
+	h.max = max(h.max, other.max)
+	return nil
+}
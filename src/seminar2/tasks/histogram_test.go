@@ -0,0 +1,87 @@
+package tasks
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramObserve(t *testing.T) {
+	t.Parallel()
+
+	h := NewHistogram([]float64{10, 1, 5, 5})
+	for _, v := range []float64{0.5, 1, 3, 7, 12, 100} {
+		h.Observe(v)
+	}
+
+	assert.Equal(t, []Bucket{
+		{UpperBound: 1, Count: 2},
+		{UpperBound: 5, Count: 1},
+		{UpperBound: 10, Count: 1},
+		{UpperBound: math.Inf(1), Count: 2},
+	}, h.Buckets())
+	assert.Equal(t, 6, h.Count())
+	assert.InDelta(t, 123.5, h.Sum(), 1e-9)
+}
+
+func TestHistogramPercentile(t *testing.T) {
+	t.Parallel()
+
+	h := NewHistogram(LinearBuckets(10, 10, 10))
+	for i := 1; i <= 100; i++ {
+		h.Observe(float64(i))
+	}
+
+	tests := []struct {
+		name     string
+		p        float64
+		expected float64
+	}{
+		{"median", 50, 50},
+		{"p90", 90, 90},
+		{"p99", 99, 99},
+		{"minimum", 0, 1},
+		{"maximum", 100, 100},
+		{"clamped above", 150, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tt.expected, h.Percentile(tt.p), 1)
+		})
+	}
+}
+
+func TestHistogramPercentileOverflowBucket(t *testing.T) {
+	t.Parallel()
+
+	h := NewHistogram([]float64{1})
+	h.Observe(5)
+	h.Observe(7)
+
+	assert.InDelta(t, 6, h.Percentile(50), 1e-9)
+	assert.True(t, math.IsNaN(NewHistogram(nil).Percentile(50)))
+}
+
+func TestHistogramMerge(t *testing.T) {
+	t.Parallel()
+
+	bounds := ExponentialBuckets(1, 2, 5)
+	assert.Equal(t, []float64{1, 2, 4, 8, 16}, bounds)
+
+	a, b := NewHistogram(bounds), NewHistogram(bounds)
+	a.Observe(1)
+	a.Observe(3)
+	b.Observe(3)
+	b.Observe(20)
+
+	assert.NoError(t, a.Merge(b))
+	assert.Equal(t, 4, a.Count())
+	assert.InDelta(t, 27, a.Sum(), 1e-9)
+	assert.Equal(t, 2, a.Buckets()[2].Count)
+	assert.InDelta(t, 20, a.Percentile(100), 1e-9)
+
+	assert.ErrorIs(t, a.Merge(NewHistogram([]float64{1, 2})), ErrBucketMismatch)
+}
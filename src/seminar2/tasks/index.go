@@ -0,0 +1,32 @@
+package tasks
+
+// FindIndexBy возвращает индекс первого элемента xs, удовлетворяющего predicate, или -1
+func FindIndexBy[T any](xs []T, predicate func(T) bool) int {
+	for i, x := range xs {
+		if predicate(x) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexBy возвращает индекс последнего элемента xs, удовлетворяющего predicate, или -1
+func LastIndexBy[T any](xs []T, predicate func(T) bool) int {
+	for i := len(xs) - 1; i >= 0; i-- {
+		if predicate(xs[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndicesOf возвращает индексы всех элементов xs, равных value, по возрастанию
+func IndicesOf[T comparable](xs []T, value T) []int {
+	var indices []int
+	for i, x := range xs {
+		if x == value {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindIndexBy(t *testing.T) {
+	t.Parallel()
+
+	isNegative := func(x int) bool { return x < 0 }
+
+	tests := []struct {
+		name  string
+		input []int
+		first int
+		last  int
+	}{
+		{"several matches", []int{1, -2, 3, -4, 5}, 1, 3},
+		{"match at edges", []int{-1, 2, -3}, 0, 2},
+		{"single match", []int{1, -2, 3}, 1, 1},
+		{"no match", []int{1, 2}, -1, -1},
+		{"empty input", nil, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.first, FindIndexBy(tt.input, isNegative))
+			assert.Equal(t, tt.last, LastIndexBy(tt.input, isNegative))
+		})
+	}
+}
+
+func TestIndicesOf(t *testing.T) {
+	t.Parallel()
+
+	letters := []rune("mississippi")
+	assert.Equal(t, []int{2, 3, 5, 6}, IndicesOf(letters, 's'))
+	assert.Equal(t, []int{0}, IndicesOf(letters, 'm'))
+	assert.Nil(t, IndicesOf(letters, 'z'))
+}
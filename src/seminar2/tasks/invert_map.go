@@ -1,9 +1,43 @@
 package tasks
 
-func invertMap[K comparable, V comparable](s map[K]V) map[V]K {
-	a := make(map[V]K)
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateValue возвращается InvertMapStrict, если одно значение встречается у нескольких ключей
+var ErrDuplicateValue = errors.New("duplicate value")
+
+// InvertMap меняет местами ключи и значения.
+// Если несколько ключей имеют одно значение, в результат попадает произвольный из них;
+// чтобы не терять ключи, используйте InvertMapStrict или InvertMapMulti
+func InvertMap[K comparable, V comparable](s map[K]V) map[V]K {
+	a := make(map[V]K, len(s))
+	for k, v := range s {
+		a[v] = k
+	}
+	return a
+}
+
+// InvertMapStrict меняет местами ключи и значения и возвращает ErrDuplicateValue,
+// если значение встречается у нескольких ключей
+func InvertMapStrict[K comparable, V comparable](s map[K]V) (map[V]K, error) {
+	a := make(map[V]K, len(s))
 	for k, v := range s {
+		if prev, ok := a[v]; ok {
+			return nil, fmt.Errorf("%w: %v for keys %v and %v", ErrDuplicateValue, v, prev, k)
+		}
 		a[v] = k
 	}
+	return a, nil
+}
+
+// InvertMapMulti сопоставляет каждому значению все ключи, у которых оно встречается.
+// Порядок ключей внутри слайса произвольный
+func InvertMapMulti[K comparable, V comparable](s map[K]V) map[V][]K {
+	a := make(map[V][]K)
+	for k, v := range s {
+		a[v] = append(a[v], k)
+	}
 	return a
 }
@@ -13,37 +13,60 @@ func TestInvertMap(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    map[string]int
-		expected map[int]string
+		expected map[int][]string // Допустимые ключи для каждого значения
 	}{
 		{
 			name:     "simple inversion",
 			input:    map[string]int{"a": 1, "b": 2, "c": 3},
-			expected: map[int]string{1: "a", 2: "b", 3: "c"},
+			expected: map[int][]string{1: {"a"}, 2: {"b"}, 3: {"c"}},
 		},
 		{
 			name:     "empty map",
 			input:    map[string]int{},
-			expected: map[int]string{},
+			expected: map[int][]string{},
 		},
 		{
 			name:     "duplicate values",
 			input:    map[string]int{"a": 1, "b": 1, "c": 2},
-			expected: map[int]string{1: "b", 2: "c"}, // Последнее значение перезаписывается
+			expected: map[int][]string{1: {"a", "b"}, 2: {"c"}}, // Побеждает произвольный из ключей
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			result := invertMap(tt.input)
+			result := InvertMap(tt.input)
 
 			if len(result) != len(tt.expected) {
 				t.Errorf("Expected %d elements, got %d", len(tt.expected), len(result))
 			}
 
 			for k, v := range tt.expected {
-				assert.Equal(t, v, result[k], fmt.Sprintf("Expected %s, got %s", tt.expected[k], result[k]))
+				assert.Contains(t, v, result[k], fmt.Sprintf("Expected one of %v, got %s", v, result[k]))
 			}
 		})
 	}
 }
+
+func TestInvertMapStrict(t *testing.T) {
+	t.Parallel()
+
+	result, err := InvertMapStrict(map[string]int{"a": 1, "b": 2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, result)
+
+	result, err = InvertMapStrict(map[string]int{"a": 1, "b": 1, "c": 2})
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.Nil(t, result)
+}
+
+func TestInvertMapMulti(t *testing.T) {
+	t.Parallel()
+
+	result := InvertMapMulti(map[string]int{"a": 1, "b": 1, "c": 2})
+	assert.Len(t, result, 2)
+	assert.ElementsMatch(t, []string{"a", "b"}, result[1])
+	assert.Equal(t, []string{"c"}, result[2])
+
+	assert.Empty(t, InvertMapMulti(map[string]int{}))
+}
@@ -0,0 +1,223 @@
+// Package iterx реализует ленивые цепочки преобразований поверх iter.Seq:
+// элементы вычисляются по одному при обходе, промежуточные слайсы не создаются
+package iterx
+
+import "iter"
+
+// Pipeline — ленивая последовательность элементов с цепочечными преобразованиями
+type Pipeline[T any] struct {
+	seq iter.Seq[T]
+}
+
+// From создает цепочку по элементам слайса
+func From[T any](xs []T) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for _, x := range xs {
+			if !yield(x) {
+				return
+			}
+		}
+	})
+}
+
+// FromSeq создает цепочку по произвольному итератору
+func FromSeq[T any](seq iter.Seq[T]) Pipeline[T] {
+	return Pipeline[T]{seq: seq}
+}
+
+// Filter оставляет элементы, удовлетворяющие predicate
+func (p Pipeline[T]) Filter(predicate func(T) bool) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for x := range p.seq {
+			if predicate(x) && !yield(x) {
+				return
+			}
+		}
+	})
+}
+
+// Map применяет f к каждому элементу; для смены типа элементов используйте функцию Map
+func (p Pipeline[T]) Map(f func(T) T) Pipeline[T] {
+	return Map(p, f)
+}
+
+// Take оставляет не более n первых элементов и прекращает обход источника после них
+func (p Pipeline[T]) Take(n int) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for x := range p.seq {
+			if !yield(x) {
+				return
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	})
+}
+
+// Drop пропускает n первых элементов
+func (p Pipeline[T]) Drop(n int) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		dropped := 0
+		for x := range p.seq {
+			if dropped < n {
+				dropped++
+				continue
+			}
+			if !yield(x) {
+				return
+			}
+		}
+	})
+}
+
+// Skip пропускает n первых элементов.
+//
+// Deprecated: используйте Drop.
+func (p Pipeline[T]) Skip(n int) Pipeline[T] {
+	return p.Drop(n)
+}
+
+// TakeWhile оставляет элементы, пока predicate возвращает true
+func (p Pipeline[T]) TakeWhile(predicate func(T) bool) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for x := range p.seq {
+			if !predicate(x) || !yield(x) {
+				return
+			}
+		}
+	})
+}
+
+// DropWhile пропускает элементы, пока predicate возвращает true, и оставляет все последующие
+func (p Pipeline[T]) DropWhile(predicate func(T) bool) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		dropping := true
+		for x := range p.seq {
+			if dropping && predicate(x) {
+				continue
+			}
+			dropping = false
+			if !yield(x) {
+				return
+			}
+		}
+	})
+}
+
+// TakeLast оставляет n последних элементов. Источник обходится целиком,
+// в памяти хранится не больше n элементов, поэтому для бесконечных источников не подходит
+func (p Pipeline[T]) TakeLast(n int) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		ring := make([]T, 0, n)
+		start := 0
+		for x := range p.seq {
+			if len(ring) < n {
+				ring = append(ring, x)
+				continue
+			}
+			ring[start] = x
+			start = (start + 1) % n
+		}
+		for i := range ring {
+			if !yield(ring[(start+i)%len(ring)]) {
+				return
+			}
+		}
+	})
+}
+
+// DropLast пропускает n последних элементов, задерживая выдачу на n элементов
+func (p Pipeline[T]) DropLast(n int) Pipeline[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if n <= 0 {
+			for x := range p.seq {
+				if !yield(x) {
+					return
+				}
+			}
+			return
+		}
+		ring := make([]T, 0, n)
+		next := 0
+		for x := range p.seq {
+			if len(ring) < n {
+				ring = append(ring, x)
+				continue
+			}
+			delayed := ring[next]
+			ring[next] = x
+			next = (next + 1) % n
+			if !yield(delayed) {
+				return
+			}
+		}
+	})
+}
+
+// Seq возвращает цепочку как iter.Seq для использования в range
+func (p Pipeline[T]) Seq() iter.Seq[T] {
+	return p.seq
+}
+
+// Collect вычисляет цепочку и возвращает элементы слайсом
+func (p Pipeline[T]) Collect() []T {
+	var result []T
+	for x := range p.seq {
+		result = append(result, x)
+	}
+	return result
+}
+
+// ForEach вызывает f для каждого элемента
+func (p Pipeline[T]) ForEach(f func(T)) {
+	for x := range p.seq {
+		f(x)
+	}
+}
+
+// Count вычисляет цепочку и возвращает количество элементов
+func (p Pipeline[T]) Count() int {
+	count := 0
+	for range p.seq {
+		count++
+	}
+	return count
+}
+
+// First возвращает первый элемент и true или нулевое значение и false для пустой цепочки
+func (p Pipeline[T]) First() (T, bool) {
+	for x := range p.seq {
+		return x, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Map применяет f к каждому элементу цепочки, возможно меняя тип элементов
+func Map[T, U any](p Pipeline[T], f func(T) U) Pipeline[U] {
+	return FromSeq(func(yield func(U) bool) {
+		for x := range p.seq {
+			if !yield(f(x)) {
+				return
+			}
+		}
+	})
+}
+
+// Reduce сворачивает элементы цепочки слева направо, начиная с init
+func Reduce[T, A any](p Pipeline[T], init A, f func(acc A, x T) A) A {
+	acc := init
+	for x := range p.seq {
+		acc = f(acc, x)
+	}
+	return acc
+}
@@ -0,0 +1,100 @@
+package iterx
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// naturals — бесконечная последовательность 1, 2, 3, ...
+func naturals(yield func(int) bool) {
+	for i := 1; ; i++ {
+		if !yield(i) {
+			return
+		}
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(x int) bool { return x%2 == 0 }
+	square := func(x int) int { return x * x }
+
+	tests := []struct {
+		name     string
+		pipeline Pipeline[int]
+		expected []int
+	}{
+		{"filter map take", From([]int{1, 2, 3, 4, 5, 6, 7, 8}).Filter(isEven).Map(square).Take(3), []int{4, 16, 36}},
+		{"take more than available", From([]int{1, 2}).Take(5), []int{1, 2}},
+		{"take zero", From([]int{1, 2}).Take(0), nil},
+		{"drop", From([]int{1, 2, 3, 4}).Drop(2), []int{3, 4}},
+		{"skip is drop", From([]int{1, 2, 3, 4}).Skip(2), []int{3, 4}},
+		{"drop while", From([]int{1, 2, 5, 1}).DropWhile(func(x int) bool { return x < 3 }), []int{5, 1}},
+		{"take last", From([]int{1, 2, 3, 4, 5}).TakeLast(2), []int{4, 5}},
+		{"take last more than available", From([]int{1, 2}).TakeLast(5), []int{1, 2}},
+		{"take last zero", From([]int{1, 2}).TakeLast(0), nil},
+		{"drop last", From([]int{1, 2, 3, 4, 5}).DropLast(2), []int{1, 2, 3}},
+		{"drop last everything", From([]int{1, 2}).DropLast(5), nil},
+		{"drop last zero", From([]int{1, 2}).DropLast(0), []int{1, 2}},
+		{"drop last on infinite source", FromSeq(naturals).DropLast(3).Take(2), []int{1, 2}},
+		{"take while", From([]int{1, 2, 5, 1}).TakeWhile(func(x int) bool { return x < 3 }), []int{1, 2}},
+		{"infinite source", FromSeq(naturals).Filter(isEven).Drop(1).Take(3), []int{4, 6, 8}},
+		{"empty source", From[int](nil).Map(square), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.pipeline.Collect())
+		})
+	}
+}
+
+func TestPipelineIsLazy(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	p := FromSeq(naturals).Map(func(x int) int {
+		calls++
+		return x * 10
+	})
+	assert.Equal(t, 0, calls, "nothing is computed before collection")
+
+	assert.Equal(t, []int{10, 20}, p.Take(2).Collect())
+	assert.Equal(t, 2, calls)
+}
+
+func TestPipelineTerminals(t *testing.T) {
+	t.Parallel()
+
+	p := From([]int{3, 1, 4, 1, 5})
+
+	assert.Equal(t, 5, p.Count())
+	first, ok := p.First()
+	assert.True(t, ok)
+	assert.Equal(t, 3, first)
+
+	_, ok = From[int](nil).First()
+	assert.False(t, ok)
+
+	sum := 0
+	p.ForEach(func(x int) { sum += x })
+	assert.Equal(t, 14, sum)
+	assert.Equal(t, 14, Reduce(p, 0, func(acc, x int) int { return acc + x }))
+
+	var seen []int
+	for x := range p.Take(2).Seq() {
+		seen = append(seen, x)
+	}
+	assert.Equal(t, []int{3, 1}, seen)
+}
+
+func TestMapChangesType(t *testing.T) {
+	t.Parallel()
+
+	labels := Map(FromSeq(naturals).Take(3), strconv.Itoa).Collect()
+	assert.Equal(t, []string{"1", "2", "3"}, labels)
+}
@@ -0,0 +1,45 @@
+package tasks
+
+import "maps"
+
+// MapDiff описывает, чем хеш-таблица b отличается от a
+type MapDiff[K comparable] struct {
+	Added   *Set[K] // ключи, которые есть только в b
+	Removed *Set[K] // ключи, которые есть только в a
+	Changed *Set[K] // общие ключи с разными значениями
+}
+
+// Empty сообщает, совпадают ли таблицы
+func (d MapDiff[K]) Empty() bool {
+	return d.Added.Len() == 0 && d.Removed.Len() == 0 && d.Changed.Len() == 0
+}
+
+// DiffMaps сравнивает таблицы a и b, сопоставляя значения оператором ==
+func DiffMaps[K, V comparable](a, b map[K]V) MapDiff[K] {
+	return DiffMapsFunc(a, b, func(x, y V) bool { return x == y })
+}
+
+// DiffMapsFunc сравнивает таблицы a и b, считая значения равными, если eq возвращает true
+func DiffMapsFunc[K comparable, V1, V2 any](a map[K]V1, b map[K]V2, eq func(V1, V2) bool) MapDiff[K] {
+	diff := MapDiff[K]{Added: NewSet[K](), Removed: NewSet[K](), Changed: NewSet[K]()}
+	for k, va := range a {
+		vb, ok := b[k]
+		switch {
+		case !ok:
+			diff.Removed.Add(k)
+		case !eq(va, vb):
+			diff.Changed.Add(k)
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			diff.Added.Add(k)
+		}
+	}
+	return diff
+}
+
+// EqualMaps сообщает, совпадают ли наборы ключей таблиц и равны ли значения по eq
+func EqualMaps[K comparable, V1, V2 any](a map[K]V1, b map[K]V2, eq func(V1, V2) bool) bool {
+	return maps.EqualFunc(a, b, eq)
+}
@@ -0,0 +1,76 @@
+package tasks
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		a, b    map[string]int
+		added   []string
+		removed []string
+		changed []string
+	}{
+		{
+			name:    "all kinds of changes",
+			a:       map[string]int{"keep": 1, "drop": 2, "edit": 3},
+			b:       map[string]int{"keep": 1, "edit": 30, "new": 4},
+			added:   []string{"new"},
+			removed: []string{"drop"},
+			changed: []string{"edit"},
+		},
+		{
+			name: "equal",
+			a:    map[string]int{"a": 1},
+			b:    map[string]int{"a": 1},
+		},
+		{
+			name:  "from empty",
+			a:     nil,
+			b:     map[string]int{"a": 1, "b": 2},
+			added: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			diff := DiffMaps(tt.a, tt.b)
+			assert.ElementsMatch(t, tt.added, slices.Collect(diff.Added.All()))
+			assert.ElementsMatch(t, tt.removed, slices.Collect(diff.Removed.All()))
+			assert.ElementsMatch(t, tt.changed, slices.Collect(diff.Changed.All()))
+			assert.Equal(t, tt.added == nil && tt.removed == nil && tt.changed == nil, diff.Empty())
+		})
+	}
+}
+
+func TestDiffMapsFunc(t *testing.T) {
+	t.Parallel()
+
+	approx := func(x, y float64) bool { return math.Abs(x-y) < 1e-9 }
+	diff := DiffMapsFunc(
+		map[string]float64{"a": 0.1 + 0.2, "b": 1},
+		map[string]float64{"a": 0.3, "b": 2},
+		approx,
+	)
+	assert.Equal(t, []string{"b"}, slices.Collect(diff.Changed.All()))
+}
+
+func TestEqualMaps(t *testing.T) {
+	t.Parallel()
+
+	sameNumber := func(n int, s string) bool { return strconv.Itoa(n) == s }
+
+	assert.True(t, EqualMaps(map[string]int{"a": 1, "b": 2}, map[string]string{"a": "1", "b": "2"}, sameNumber))
+	assert.False(t, EqualMaps(map[string]int{"a": 1}, map[string]string{"a": "2"}, sameNumber))
+	assert.False(t, EqualMaps(map[string]int{"a": 1}, map[string]string{"a": "1", "b": "2"}, sameNumber))
+	assert.True(t, EqualMaps(map[string]int{}, map[string]string(nil), sameNumber))
+}
@@ -0,0 +1,79 @@
+package tasks
+
+// MapKeys применяет f к каждому ключу; если f отображает разные ключи в один, остается произвольное из значений
+func MapKeys[K, K2 comparable, V any](m map[K]V, f func(K) K2) map[K2]V {
+	return MapEntries(m, func(k K, v V) (K2, V) {
+		return f(k), v
+	})
+}
+
+// MapValues применяет f к каждому значению, сохраняя ключи
+func MapValues[K comparable, V, V2 any](m map[K]V, f func(V) V2) map[K]V2 {
+	return MapEntries(m, func(k K, v V) (K, V2) {
+		return k, f(v)
+	})
+}
+
+// FilterMap оставляет пары, для которых predicate вернул true
+func FilterMap[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+	for k, v := range m {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MapEntries преобразует каждую пару, меняя типы и ключа, и значения.
+// При совпадении новых ключей остается произвольная из пар
+func MapEntries[K, K2 comparable, V, V2 any](m map[K]V, f func(K, V) (K2, V2)) map[K2]V2 {
+	result := make(map[K2]V2, len(m))
+	for k, v := range m {
+		k2, v2 := f(k, v)
+		result[k2] = v2
+	}
+	return result
+}
+
+// PickKeys возвращает копию m только с перечисленными ключами; отсутствующие в m ключи пропускаются
+func PickKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// SubMap — строгий вариант PickKeys: возвращает копию m с перечисленными ключами
+// и false, если хотя бы одного из них нет в m
+func SubMap[K comparable, V any](m map[K]V, keys ...K) (map[K]V, bool) {
+	result := PickKeys(m, keys...)
+	for _, k := range keys {
+		if _, ok := result[k]; !ok {
+			return result, false
+		}
+	}
+	return result, true
+}
+
+// OmitKeys возвращает копию m без перечисленных ключей
+func OmitKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omit := toSet(keys)
+	return OmitBy(m, func(k K, _ V) bool {
+		_, ok := omit[k]
+		return ok
+	})
+}
+
+// PickBy возвращает копию m с парами, для которых predicate вернул true
+func PickBy[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	return FilterMap(m, predicate)
+}
+
+// OmitBy возвращает копию m без пар, для которых predicate вернул true
+func OmitBy[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	return FilterMap(m, func(k K, v V) bool { return !predicate(k, v) })
+}
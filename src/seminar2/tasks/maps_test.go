@@ -0,0 +1,114 @@
+package tasks
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapKeys(t *testing.T) {
+	t.Parallel()
+
+	result := MapKeys(map[string]int{"a": 1, "b": 2}, strings.ToUpper)
+	assert.Equal(t, map[string]int{"A": 1, "B": 2}, result)
+
+	collided := MapKeys(map[string]int{"a": 1, "A": 1}, strings.ToLower)
+	assert.Equal(t, map[string]int{"a": 1}, collided)
+
+	assert.Empty(t, MapKeys(map[string]int{}, strings.ToUpper))
+}
+
+func TestMapValues(t *testing.T) {
+	t.Parallel()
+
+	result := MapValues(map[string]int{"a": 1, "b": 2}, strconv.Itoa)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, result)
+}
+
+func TestFilterMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     map[string]int
+		predicate func(string, int) bool
+		expected  map[string]int
+	}{
+		{
+			name:      "by value",
+			input:     map[string]int{"a": 1, "b": 2, "c": 3},
+			predicate: func(_ string, v int) bool { return v%2 == 1 },
+			expected:  map[string]int{"a": 1, "c": 3},
+		},
+		{
+			name:      "by key",
+			input:     map[string]int{"apple": 1, "banana": 2, "avocado": 3},
+			predicate: func(k string, _ int) bool { return strings.HasPrefix(k, "a") },
+			expected:  map[string]int{"apple": 1, "avocado": 3},
+		},
+		{
+			name:      "nothing matches",
+			input:     map[string]int{"a": 1},
+			predicate: func(string, int) bool { return false },
+			expected:  map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, FilterMap(tt.input, tt.predicate))
+		})
+	}
+}
+
+func TestMapEntries(t *testing.T) {
+	t.Parallel()
+
+	result := MapEntries(map[string]int{"a": 1, "bb": 2}, func(k string, v int) (int, string) {
+		return len(k), strings.Repeat(k, v)
+	})
+	assert.Equal(t, map[int]string{1: "a", 2: "bbbb"}, result)
+}
+
+func TestPickOmitKeys(t *testing.T) {
+	t.Parallel()
+
+	config := map[string]string{"host": "localhost", "port": "8080", "password": "secret"}
+
+	assert.Equal(t, map[string]string{"host": "localhost", "port": "8080"}, PickKeys(config, "host", "port", "missing"))
+	assert.Equal(t, map[string]string{"host": "localhost", "port": "8080"}, OmitKeys(config, "password", "missing"))
+	assert.Empty(t, PickKeys(config))
+	assert.Equal(t, config, OmitKeys(config))
+	assert.Len(t, config, 3, "the source map is not modified")
+}
+
+func TestSubMap(t *testing.T) {
+	t.Parallel()
+
+	config := map[string]int{"port": 8080, "workers": 4, "timeout": 30}
+
+	sub, ok := SubMap(config, "port", "workers")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int{"port": 8080, "workers": 4}, sub)
+
+	sub, ok = SubMap(config, "port", "missing")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]int{"port": 8080}, sub)
+
+	sub, ok = SubMap(config)
+	assert.True(t, ok)
+	assert.Empty(t, sub)
+}
+
+func TestPickOmitBy(t *testing.T) {
+	t.Parallel()
+
+	response := map[string]any{"id": 7, "name": "bob", "token": nil, "email": nil}
+	isNil := func(_ string, v any) bool { return v == nil }
+
+	assert.Equal(t, map[string]any{"token": nil, "email": nil}, PickBy(response, isNil))
+	assert.Equal(t, map[string]any{"id": 7, "name": "bob"}, OmitBy(response, isNil))
+}
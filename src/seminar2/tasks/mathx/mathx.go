@@ -0,0 +1,180 @@
+// Package mathx собирает целочисленные алгоритмы, которые нужны почти на каждом семинаре по алгоритмам:
+// НОД и НОК, возведение в степень с контролем переполнения, решето Эратосфена, факторизацию и числа Фибоначчи
+package mathx
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	// ErrOverflow возвращается, когда результат не помещается в тип
+	ErrOverflow = errors.New("integer overflow")
+	// ErrNegative возвращается для отрицательного аргумента там, где он не имеет смысла
+	ErrNegative = errors.New("negative argument")
+)
+
+// Integer объединяет все целочисленные типы
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// GCD возвращает наибольший общий делитель по алгоритму Евклида; результат неотрицателен, GCD(0, 0) = 0
+func GCD[T Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return abs(a)
+}
+
+// LCM возвращает наименьшее общее кратное; если один из аргументов равен нулю, результат равен нулю
+func LCM[T Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return abs(a / GCD(a, b) * b)
+}
+
+// Pow возводит base в степень exp быстрым возведением в квадрат.
+// Возвращает ErrNegative для отрицательного exp и ErrOverflow, если результат не помещается в int
+func Pow(base, exp int) (int, error) {
+	if exp < 0 {
+		return 0, ErrNegative
+	}
+	result := 1
+	for {
+		var ok bool
+		if exp&1 == 1 {
+			if result, ok = mulChecked(result, base); !ok {
+				return 0, ErrOverflow
+			}
+		}
+		exp >>= 1
+		if exp == 0 {
+			return result, nil
+		}
+		if base, ok = mulChecked(base, base); !ok {
+			return 0, ErrOverflow
+		}
+	}
+}
+
+// PrimeSieve возвращает все простые числа, не превосходящие n, решетом Эратосфена
+func PrimeSieve(n int) []int {
+	if n < 2 {
+		return nil
+	}
+	composite := make([]bool, n+1)
+	var primes []int
+	for i := 2; i <= n; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j <= n && j > 0; j += i {
+			composite[j] = true
+		}
+	}
+	return primes
+}
+
+// Factorize раскладывает n на простые множители в порядке неубывания: 12 -> [2 2 3].
+// Для n < 2 возвращает nil
+func Factorize(n int) []int {
+	var factors []int
+	for p := 2; n >= 2 && p <= n/p; p++ {
+		for n%p == 0 {
+			factors = append(factors, p)
+			n /= p
+		}
+	}
+	if n >= 2 {
+		factors = append(factors, n)
+	}
+	return factors
+}
+
+// maxFibonacci — наибольший номер числа Фибоначчи, помещающегося в uint64
+const maxFibonacci = 93
+
+// Fibonacci возвращает n-е число Фибоначчи (F(0) = 0, F(1) = 1) итеративно за O(n).
+// Возвращает ErrNegative для n < 0 и ErrOverflow, если число не помещается в uint64
+func Fibonacci(n int) (uint64, error) {
+	if err := checkFibonacci(n); err != nil {
+		return 0, err
+	}
+	var a, b uint64 = 0, 1
+	for range n {
+		a, b = b, a+b
+	}
+	return a, nil
+}
+
+// FibMemo вычисляет числа Фибоначчи рекурсивно, запоминая уже найденные значения.
+// Нулевое значение готово к использованию; FibMemo не безопасен для конкурентного использования
+type FibMemo struct {
+	memo map[int]uint64
+}
+
+// Get возвращает n-е число Фибоначчи с теми же ошибками, что и Fibonacci
+func (m *FibMemo) Get(n int) (uint64, error) {
+	if err := checkFibonacci(n); err != nil {
+		return 0, err
+	}
+	if m.memo == nil {
+		m.memo = map[int]uint64{0: 0, 1: 1}
+	}
+	return m.get(n), nil
+}
+
+func (m *FibMemo) get(n int) uint64 {
+	if v, ok := m.memo[n]; ok {
+		return v
+	}
+	v := m.get(n-1) + m.get(n-2)
+	m.memo[n] = v
+	return v
+}
+
+func checkFibonacci(n int) error {
+	switch {
+	case n < 0:
+		return ErrNegative
+	case n > maxFibonacci:
+		return ErrOverflow
+	}
+	return nil
+}
+
+// NextPow2 возвращает наименьшую степень двойки, не меньшую n; NextPow2(0) = 1.
+// Возвращает ErrOverflow, если такая степень не помещается в uint64
+func NextPow2(n uint64) (uint64, error) {
+	if n <= 1 {
+		return 1, nil
+	}
+	shift := bits.Len64(n - 1)
+	if shift == 64 {
+		return 0, ErrOverflow
+	}
+	return 1 << shift, nil
+}
+
+func mulChecked(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, false
+	}
+	c := a * b
+	return c, c/b == a
+}
+
+func abs[T Integer](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
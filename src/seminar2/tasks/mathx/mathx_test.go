@@ -0,0 +1,146 @@
+package mathx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCDAndLCM(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     int
+		gcd, lcm int
+	}{
+		{"coprime", 9, 28, 1, 252},
+		{"common divisor", 12, 18, 6, 36},
+		{"negative", -12, 18, 6, 36},
+		{"zero", 0, 5, 5, 0},
+		{"both zero", 0, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.gcd, GCD(tt.a, tt.b))
+			assert.Equal(t, tt.lcm, LCM(tt.a, tt.b))
+		})
+	}
+
+	assert.Equal(t, uint8(4), GCD[uint8](200, 12))
+}
+
+func TestPow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		base, exp int
+		expected  int
+		err       error
+	}{
+		{"zero exponent", 7, 0, 1, nil},
+		{"power of two", 2, 10, 1024, nil},
+		{"negative base", -3, 3, -27, nil},
+		{"largest power of two", 2, 62, 1 << 62, nil},
+		{"min int", -2, 63, math.MinInt, nil},
+		{"overflow", 2, 63, 0, ErrOverflow},
+		{"overflow on square", 10, 40, 0, ErrOverflow},
+		{"negative exponent", 2, -1, 0, ErrNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Pow(tt.base, tt.exp)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPrimeSieve(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{2, 3, 5, 7, 11, 13, 17, 19}, PrimeSieve(20))
+	assert.Equal(t, []int{2}, PrimeSieve(2))
+	assert.Nil(t, PrimeSieve(1))
+	assert.Len(t, PrimeSieve(10000), 1229)
+}
+
+func TestFactorize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"composite", 360, []int{2, 2, 2, 3, 3, 5}},
+		{"prime", 97, []int{97}},
+		{"large prime factor", 2 * 1000003, []int{2, 1000003}},
+		{"one", 1, nil},
+		{"negative", -6, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Factorize(tt.n))
+		})
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	t.Parallel()
+
+	var memo FibMemo
+	for n, expected := range []uint64{0, 1, 1, 2, 3, 5, 8, 13, 21, 34} {
+		got, err := Fibonacci(n)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+
+		got, err = memo.Get(n)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got)
+	}
+
+	last, err := Fibonacci(93)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12200160415121876738), last)
+
+	memoLast, err := memo.Get(93)
+	assert.NoError(t, err)
+	assert.Equal(t, last, memoLast)
+
+	_, err = Fibonacci(94)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = memo.Get(-1)
+	assert.ErrorIs(t, err, ErrNegative)
+}
+
+func TestNextPow2(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n        uint64
+		expected uint64
+		err      error
+	}{
+		{0, 1, nil},
+		{1, 1, nil},
+		{5, 8, nil},
+		{64, 64, nil},
+		{1 << 63, 1 << 63, nil},
+		{1<<63 + 1, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		got, err := NextPow2(tt.n)
+		assert.ErrorIs(t, err, tt.err, tt.n)
+		assert.Equal(t, tt.expected, got, tt.n)
+	}
+}
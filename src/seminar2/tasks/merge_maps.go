@@ -0,0 +1,37 @@
+package tasks
+
+// MergeMaps копирует пары из src в dst. Если ключ уже есть в dst,
+// в dst записывается resolve(k, old, new), где old — значение из dst, new — из src
+func MergeMaps[K comparable, V any](dst, src map[K]V, resolve func(k K, old, new V) V) {
+	for k, v := range src {
+		if old, ok := dst[k]; ok {
+			v = resolve(k, old, v)
+		}
+		dst[k] = v
+	}
+}
+
+// MergeAllMaps объединяет maps слева направо в новую хеш-таблицу, разрешая конфликты через resolve.
+// Исходные таблицы не меняются
+func MergeAllMaps[K comparable, V any](resolve func(k K, old, new V) V, maps ...map[K]V) map[K]V {
+	result := make(map[K]V)
+	for _, m := range maps {
+		MergeMaps(result, m, resolve)
+	}
+	return result
+}
+
+// KeepOld — политика слияния, оставляющая уже записанное значение
+func KeepOld[K comparable, V any](_ K, old, _ V) V {
+	return old
+}
+
+// KeepNew — политика слияния, заменяющая значение новым
+func KeepNew[K comparable, V any](_ K, _, new V) V {
+	return new
+}
+
+// SumValues — политика слияния, складывающая значения
+func SumValues[K comparable, V Number](_ K, old, new V) V {
+	return old + new
+}
@@ -0,0 +1,54 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeMaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		resolve  func(string, int, int) int
+		expected map[string]int
+	}{
+		{"keep old", KeepOld[string, int], map[string]int{"a": 1, "b": 2, "c": 30}},
+		{"keep new", KeepNew[string, int], map[string]int{"a": 1, "b": 20, "c": 30}},
+		{"sum", SumValues[string, int], map[string]int{"a": 1, "b": 22, "c": 30}},
+		{
+			name:     "custom",
+			resolve:  func(_ string, old, new int) int { return max(old, new) },
+			expected: map[string]int{"a": 1, "b": 20, "c": 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dst := map[string]int{"a": 1, "b": 2}
+			src := map[string]int{"b": 20, "c": 30}
+
+			MergeMaps(dst, src, tt.resolve)
+			assert.Equal(t, tt.expected, dst)
+			assert.Equal(t, map[string]int{"b": 20, "c": 30}, src)
+		})
+	}
+}
+
+func TestMergeAllMaps(t *testing.T) {
+	t.Parallel()
+
+	workers := []map[string]int{
+		{"go": 2, "rust": 1},
+		{"go": 3},
+		{"python": 4, "rust": 1},
+	}
+
+	result := MergeAllMaps(SumValues, workers...)
+	assert.Equal(t, map[string]int{"go": 5, "rust": 2, "python": 4}, result)
+	assert.Equal(t, map[string]int{"go": 2, "rust": 1}, workers[0])
+
+	assert.Empty(t, MergeAllMaps(KeepNew[string, int]))
+}
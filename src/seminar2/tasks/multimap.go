@@ -0,0 +1,121 @@
+package tasks
+
+import (
+	"iter"
+	"slices"
+)
+
+// MultiMap хранит по каждому ключу список значений в порядке добавления (отношение «один ко многим»)
+type MultiMap[K comparable, V any] struct {
+	values map[K][]V
+	size   int
+	eq     func(a, b V) bool
+}
+
+// NewMultiMap создает пустую таблицу, в которой Remove сравнивает значения оператором ==
+func NewMultiMap[K, V comparable]() *MultiMap[K, V] {
+	return NewMultiMapFunc[K](func(a, b V) bool { return a == b })
+}
+
+// NewMultiMapFunc создает пустую таблицу, в которой Remove сравнивает значения функцией eq.
+// Подходит для несравнимых значений: слайсов, map, функций
+func NewMultiMapFunc[K comparable, V any](eq func(a, b V) bool) *MultiMap[K, V] {
+	return &MultiMap[K, V]{values: make(map[K][]V), eq: eq}
+}
+
+// Add добавляет значения к ключу key; без значений таблица не меняется
+func (m *MultiMap[K, V]) Add(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m.values[key] = append(m.values[key], values...)
+	m.size += len(values)
+}
+
+// Get возвращает копию значений ключа; для отсутствующего ключа — nil
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return slices.Clone(m.values[key])
+}
+
+// Has сообщает, есть ли у ключа хотя бы одно значение
+func (m *MultiMap[K, V]) Has(key K) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+// Remove удаляет первое вхождение value у ключа key и сообщает, было ли оно.
+// Ключ без значений удаляется из таблицы. Значения сравниваются функцией, заданной при создании
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	vs := m.values[key]
+	i := slices.IndexFunc(vs, func(v V) bool { return m.eq(v, value) })
+	if i < 0 {
+		return false
+	}
+	m.setValues(key, slices.Delete(vs, i, i+1))
+	m.size--
+	return true
+}
+
+// RemoveFunc удаляет у ключа key все значения, для которых predicate вернул true,
+// и возвращает их количество. Ключ без значений удаляется из таблицы
+func (m *MultiMap[K, V]) RemoveFunc(key K, predicate func(V) bool) int {
+	vs, ok := m.values[key]
+	if !ok {
+		return 0
+	}
+	kept := slices.DeleteFunc(vs, predicate)
+	removed := len(vs) - len(kept)
+	m.setValues(key, kept)
+	m.size -= removed
+	return removed
+}
+
+// RemoveAll удаляет ключ со всеми значениями и возвращает их количество
+func (m *MultiMap[K, V]) RemoveAll(key K) int {
+	n := len(m.values[key])
+	delete(m.values, key)
+	m.size -= n
+	return n
+}
+
+// Len возвращает общее количество значений
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// KeyCount возвращает количество ключей
+func (m *MultiMap[K, V]) KeyCount() int {
+	return len(m.values)
+}
+
+// Keys перечисляет ключи в произвольном порядке
+func (m *MultiMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.values {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// All перечисляет все пары ключ-значение: ключи в произвольном порядке, значения ключа — в порядке добавления
+func (m *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, vs := range m.values {
+			for _, v := range vs {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *MultiMap[K, V]) setValues(key K, vs []V) {
+	if len(vs) == 0 {
+		delete(m.values, key)
+		return
+	}
+	m.values[key] = vs
+}
@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiMap(t *testing.T) {
+	t.Parallel()
+
+	m := NewMultiMap[string, string]()
+	m.Add("alice", "math", "physics")
+	m.Add("bob", "math")
+	m.Add("alice", "math")
+
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, 2, m.KeyCount())
+	assert.Equal(t, []string{"math", "physics", "math"}, m.Get("alice"))
+	assert.Nil(t, m.Get("carol"))
+	assert.True(t, m.Has("bob"))
+	assert.False(t, m.Has("carol"))
+	assert.ElementsMatch(t, []string{"alice", "bob"}, slices.Collect(m.Keys()))
+
+	got := m.Get("alice")
+	got[0] = "changed"
+	assert.Equal(t, "math", m.Get("alice")[0], "Get returns a copy")
+}
+
+func TestMultiMapRemove(t *testing.T) {
+	t.Parallel()
+
+	m := NewMultiMap[string, int]()
+	m.Add("a", 1, 2, 1)
+	m.Add("b", 3)
+
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, []int{2, 1}, m.Get("a"))
+	assert.False(t, m.Remove("a", 5))
+	assert.False(t, m.Remove("c", 1))
+
+	assert.True(t, m.Remove("b", 3))
+	assert.False(t, m.Has("b"))
+	assert.Equal(t, 2, m.Len())
+
+	assert.Equal(t, 2, m.RemoveAll("a"))
+	assert.Equal(t, 0, m.RemoveAll("a"))
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, 0, m.KeyCount())
+}
+
+func TestMultiMapRemoveFunc(t *testing.T) {
+	t.Parallel()
+
+	m := NewMultiMapFunc[string](slices.Equal[[]int])
+	m.Add("a", []int{1}, []int{2, 3}, []int{})
+	m.Add("b", []int{4})
+
+	removed := m.RemoveFunc("a", func(v []int) bool { return len(v) != 1 })
+	assert.Equal(t, 2, removed)
+	assert.Equal(t, [][]int{{1}}, m.Get("a"))
+	assert.Equal(t, 2, m.Len())
+
+	assert.Equal(t, 1, m.RemoveFunc("b", func([]int) bool { return true }))
+	assert.False(t, m.Has("b"))
+	assert.Equal(t, 0, m.RemoveFunc("missing", func([]int) bool { return true }))
+
+	assert.False(t, m.Remove("a", []int{2}))
+	assert.True(t, m.Remove("a", []int{1}))
+	assert.False(t, m.Has("a"))
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestMultiMapAddNothing(t *testing.T) {
+	t.Parallel()
+
+	m := NewMultiMap[string, int]()
+	m.Add("a")
+	assert.False(t, m.Has("a"))
+	assert.Equal(t, 0, m.KeyCount())
+	assert.Equal(t, 0, m.Len())
+
+	m.Add("a", 1)
+	m.Add("a")
+	assert.Equal(t, []int{1}, m.Get("a"))
+}
+
+func TestMultiMapAll(t *testing.T) {
+	t.Parallel()
+
+	m := NewMultiMap[string, int]()
+	m.Add("a", 1, 2)
+	m.Add("b", 3)
+
+	type entry struct {
+		key   string
+		value int
+	}
+	var entries []entry
+	for k, v := range m.All() {
+		entries = append(entries, entry{k, v})
+	}
+	assert.ElementsMatch(t, []entry{{"a", 1}, {"a", 2}, {"b", 3}}, entries)
+
+	count := 0
+	for range m.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
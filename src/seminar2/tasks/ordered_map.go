@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap — хеш-таблица, перечисляющая ключи в порядке их первой вставки.
+// Нулевое значение готово к использованию
+type OrderedMap[K comparable, V any] struct {
+	index   map[K]*list.Element
+	entries list.List
+}
+
+// NewOrderedMap создает пустую упорядоченную хеш-таблицу
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+// Get возвращает значение по ключу и признак его наличия
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.index[key]; ok {
+		return e.Value.(*orderedEntry[K, V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set записывает значение; существующий ключ сохраняет свою позицию
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.index[key]; ok {
+		e.Value.(*orderedEntry[K, V]).value = value
+		return
+	}
+	if m.index == nil {
+		m.index = make(map[K]*list.Element)
+	}
+	m.index[key] = m.entries.PushBack(&orderedEntry[K, V]{key: key, value: value})
+}
+
+// Delete удаляет ключ и сообщает, был ли он в таблице
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	m.entries.Remove(e)
+	delete(m.index, key)
+	return true
+}
+
+// Len возвращает количество ключей
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+// All перечисляет пары в порядке вставки
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.entries.Front(); e != nil; e = e.Next() {
+			entry := e.Value.(*orderedEntry[K, V])
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys перечисляет ключи в порядке вставки
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON кодирует таблицу как JSON-объект с ключами в порядке вставки.
+// Ключи, которые не кодируются в JSON-строку (например, числа), записываются своим JSON-представлением
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	i := 0
+	for k, v := range m.All() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		i++
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		if key[0] != '"' {
+			if key, err = json.Marshal(string(key)); err != nil {
+				return nil, err
+			}
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON заменяет содержимое таблицы парами JSON-объекта в порядке их следования.
+// Литерал null, как и в encoding/json, ничего не меняет
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return fmt.Errorf("ordered map: expected JSON object, got %v", tok)
+	}
+
+	*m = OrderedMap[K, V]{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeOrderedKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		m.Set(key, value)
+	}
+	_, err := dec.Token()
+	return err
+}
+
+func decodeOrderedKey[K comparable](s string) (K, error) {
+	var key K
+	quoted, err := json.Marshal(s)
+	if err != nil {
+		return key, err
+	}
+	if json.Unmarshal(quoted, &key) == nil {
+		return key, nil
+	}
+	if err := json.Unmarshal([]byte(s), &key); err != nil {
+		return key, fmt.Errorf("ordered map: decode key %q: %w", s, err)
+	}
+	return key, nil
+}
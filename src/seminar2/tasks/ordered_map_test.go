@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 30)
+
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(m.Keys()))
+
+	v, ok := m.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 30, v)
+
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+	_, ok = m.Get("a")
+	assert.False(t, ok)
+
+	m.Set("a", 10)
+	assert.Equal(t, map[string]int{"c": 30, "b": 2, "a": 10}, maps.Collect(m.All()))
+	assert.Equal(t, []string{"c", "b", "a"}, slices.Collect(m.Keys()))
+
+	for k := range m.Keys() {
+		assert.Equal(t, "c", k)
+		break
+	}
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var m OrderedMap[int, string]
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Delete(1))
+
+	m.Set(1, "one")
+	v, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "one", v)
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrderedMap[string, []int]()
+	m.Set("z", []int{1})
+	m.Set("a", nil)
+	m.Set("m", []int{2, 3})
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"z":[1],"a":null,"m":[2,3]}`, string(data))
+
+	decoded := NewOrderedMap[string, []int]()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, []string{"z", "a", "m"}, slices.Collect(decoded.Keys()))
+
+	empty, err := json.Marshal(NewOrderedMap[string, int]())
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(empty))
+}
+
+func TestOrderedMapJSONNumericKeys(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrderedMap[int, string]()
+	m.Set(10, "ten")
+	m.Set(2, "two")
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"10":"ten","2":"two"}`, string(data))
+
+	var decoded OrderedMap[int, string]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, []int{10, 2}, slices.Collect(decoded.Keys()))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"x":"bad"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`[1, 2]`), &decoded))
+}
+
+func TestOrderedMapJSONNull(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), m))
+	assert.NoError(t, m.UnmarshalJSON([]byte(` null `)))
+	assert.Equal(t, []string{"a"}, slices.Collect(m.Keys()))
+}
@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ParallelMap применяет f к элементам xs в workers горутинах и возвращает результаты в исходном порядке.
+// Если workers не положителен, используется runtime.GOMAXPROCS(0)
+func ParallelMap[T, U any](xs []T, workers int, f func(T) U) []U {
+	result := make([]U, len(xs))
+	runParallel(len(xs), workers, func(i int) {
+		result[i] = f(xs[i])
+	})
+	return result
+}
+
+// ParallelFilter проверяет элементы xs на predicate в workers горутинах
+// и возвращает подходящие в исходном порядке.
+// Если workers не положителен, используется runtime.GOMAXPROCS(0)
+func ParallelFilter[T any](xs []T, workers int, predicate func(T) bool) []T {
+	keep := ParallelMap(xs, workers, predicate)
+	var result []T
+	for i, x := range xs {
+		if keep[i] {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+// runParallel вызывает fn для каждого индекса из [0, n) в пуле из workers горутин
+// и дожидается завершения всех вызовов
+func runParallel(n, workers int, fn func(i int)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				fn(i)
+			}
+		}()
+	}
+	for i := range n {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+}
@@ -0,0 +1,76 @@
+package tasks
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   []int
+		workers int
+	}{
+		{"single worker", []int{1, 2, 3, 4, 5}, 1},
+		{"more workers than items", []int{1, 2, 3}, 10},
+		{"default workers", []int{5, 4, 3, 2, 1, 0}, 0},
+		{"empty input", []int{}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			square := func(x int) int { return x * x }
+			assert.Equal(t, Map(tt.input, square), ParallelMap(tt.input, tt.workers, square))
+		})
+	}
+}
+
+func TestParallelMapBoundsWorkers(t *testing.T) {
+	t.Parallel()
+
+	var running, peak atomic.Int32
+	input := make([]int, 20)
+	ParallelMap(input, 3, func(x int) int {
+		n := running.Add(1)
+		for {
+			old := peak.Load()
+			if n <= old || peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+		return x
+	})
+
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+func TestParallelFilter(t *testing.T) {
+	t.Parallel()
+
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	isPrime := func(n int) bool {
+		if n < 2 {
+			return false
+		}
+		for d := 2; d*d <= n; d++ {
+			if n%d == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	assert.Equal(t, Filter(input, isPrime), ParallelFilter(input, 4, isPrime))
+	assert.Nil(t, ParallelFilter([]int{1, 4}, 2, isPrime))
+}
@@ -0,0 +1,35 @@
+package tasks
+
+// Reverse переворачивает xs на месте
+func Reverse[T any](xs []T) {
+	for i, j := 0, len(xs)-1; i < j; i, j = i+1, j-1 {
+		xs[i], xs[j] = xs[j], xs[i]
+	}
+}
+
+// Rotate циклически сдвигает xs на месте влево на k позиций, так что первым становится xs[k].
+// Отрицательный k сдвигает вправо
+func Rotate[T any](xs []T, k int) {
+	if len(xs) == 0 {
+		return
+	}
+	k = ((k % len(xs)) + len(xs)) % len(xs)
+	Reverse(xs[:k])
+	Reverse(xs[k:])
+	Reverse(xs)
+}
+
+// Interleave чередует элементы a и b: a[0], b[0], a[1], b[1], ...
+// Оставшиеся элементы более длинного слайса идут в конце
+func Interleave[T any](a, b []T) []T {
+	result := make([]T, 0, len(a)+len(b))
+	for i := range max(len(a), len(b)) {
+		if i < len(a) {
+			result = append(result, a[i])
+		}
+		if i < len(b) {
+			result = append(result, b[i])
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"odd length", []int{1, 2, 3}, []int{3, 2, 1}},
+		{"even length", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{"empty input", []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			Reverse(tt.input)
+			assert.Equal(t, tt.expected, tt.input)
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		k        int
+		expected []int
+	}{
+		{"left by two", 2, []int{3, 4, 5, 1, 2}},
+		{"right by one", -1, []int{5, 1, 2, 3, 4}},
+		{"full turn", 5, []int{1, 2, 3, 4, 5}},
+		{"more than length", 7, []int{3, 4, 5, 1, 2}},
+		{"zero", 0, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			xs := []int{1, 2, 3, 4, 5}
+			Rotate(xs, tt.k)
+			assert.Equal(t, tt.expected, xs)
+		})
+	}
+
+	var empty []int
+	Rotate(empty, 3)
+	assert.Empty(t, empty)
+}
+
+func TestInterleave(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		a, b     []string
+		expected []string
+	}{
+		{"equal lengths", []string{"a", "b"}, []string{"1", "2"}, []string{"a", "1", "b", "2"}},
+		{"first longer", []string{"a", "b", "c"}, []string{"1"}, []string{"a", "1", "b", "c"}},
+		{"second longer", []string{"a"}, []string{"1", "2", "3"}, []string{"a", "1", "2", "3"}},
+		{"both empty", nil, nil, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Interleave(tt.a, tt.b))
+		})
+	}
+}
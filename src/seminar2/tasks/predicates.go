@@ -0,0 +1,58 @@
+package tasks
+
+import "context"
+
+// All сообщает, удовлетворяют ли predicate все элементы xs; для пустого xs — true.
+// Обход прекращается на первом неподходящем элементе
+func All[T any](xs []T, predicate func(T) bool) bool {
+	for _, x := range xs {
+		if !predicate(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any сообщает, удовлетворяет ли predicate хотя бы один элемент xs; для пустого xs — false.
+// Обход прекращается на первом подходящем элементе
+func Any[T any](xs []T, predicate func(T) bool) bool {
+	for _, x := range xs {
+		if predicate(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// None сообщает, что ни один элемент xs не удовлетворяет predicate
+func None[T any](xs []T, predicate func(T) bool) bool {
+	return !Any(xs, predicate)
+}
+
+// AnyFrom читает элементы из ch, пока не найдет подходящий (true) или канал не закроется (false).
+// При отмене ctx возвращает ошибку контекста. Оставшиеся в канале элементы не вычитываются
+func AnyFrom[T any](ctx context.Context, ch <-chan T, predicate func(T) bool) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case x, ok := <-ch:
+			if !ok {
+				return false, nil
+			}
+			if predicate(x) {
+				return true, nil
+			}
+		}
+	}
+}
+
+// AllFrom читает элементы из ch, пока не найдет неподходящий (false) или канал не закроется (true).
+// При отмене ctx возвращает ошибку контекста
+func AllFrom[T any](ctx context.Context, ch <-chan T, predicate func(T) bool) (bool, error) {
+	found, err := AnyFrom(ctx, ch, func(x T) bool { return !predicate(x) })
+	if err != nil {
+		return false, err
+	}
+	return !found, nil
+}
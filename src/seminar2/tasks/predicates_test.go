@@ -0,0 +1,103 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllAnyNone(t *testing.T) {
+	t.Parallel()
+
+	isPositive := func(x int) bool { return x > 0 }
+
+	tests := []struct {
+		name string
+		xs   []int
+		all  bool
+		any  bool
+		none bool
+	}{
+		{"all positive", []int{1, 2, 3}, true, true, false},
+		{"some positive", []int{-1, 2, -3}, false, true, false},
+		{"none positive", []int{-1, 0}, false, false, true},
+		{"empty input", nil, true, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.all, All(tt.xs, isPositive))
+			assert.Equal(t, tt.any, Any(tt.xs, isPositive))
+			assert.Equal(t, tt.none, None(tt.xs, isPositive))
+		})
+	}
+}
+
+func TestAllAnyShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	counting := func(x int) bool {
+		calls++
+		return x > 0
+	}
+
+	Any([]int{-1, 5, 6, 7}, counting)
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	All([]int{1, -5, 6, 7}, counting)
+	assert.Equal(t, 2, calls)
+}
+
+// produce отправляет xs в канал и закрывает его
+func produce(xs ...int) <-chan int {
+	ch := make(chan int, len(xs))
+	for _, x := range xs {
+		ch <- x
+	}
+	close(ch)
+	return ch
+}
+
+func TestAnyFrom(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	found, err := AnyFrom(context.Background(), produce(1, 3, 4, 5), isEven)
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	found, err = AnyFrom(context.Background(), produce(1, 3), isEven)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = AnyFrom(ctx, make(chan int), isEven)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestAllFrom(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	all, err := AllFrom(context.Background(), produce(2, 4, 6), isEven)
+	assert.NoError(t, err)
+	assert.True(t, all)
+
+	ch := produce(2, 3, 4)
+	all, err = AllFrom(context.Background(), ch, isEven)
+	assert.NoError(t, err)
+	assert.False(t, all)
+	assert.Equal(t, 4, <-ch, "stops reading at the first failing element")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = AllFrom(ctx, make(chan int), isEven)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// ErrInvalidWeights возвращается WeightedSample, если веса не соответствуют элементам
+var ErrInvalidWeights = errors.New("invalid weights")
+
+// Shuffle случайно переставляет элементы xs на месте.
+// Здесь и далее при rng == nil используется глобальный источник, а с rng, созданным
+// с фиксированным seed, результат воспроизводим
+func Shuffle[T any](xs []T, rng *rand.Rand) {
+	swap := func(i, j int) {
+		xs[i], xs[j] = xs[j], xs[i]
+	}
+	if rng == nil {
+		rand.Shuffle(len(xs), swap)
+		return
+	}
+	rng.Shuffle(len(xs), swap)
+}
+
+// Sample возвращает n случайных элементов xs без повторного выбора одной позиции.
+// n приводится к отрезку [0, len(xs)], xs не изменяется
+func Sample[T any](xs []T, n int, rng *rand.Rand) []T {
+	n = clampIndex(n, len(xs))
+	pool := slices.Clone(xs)
+	// Частичный Фишер-Йетс: после шага i первые i+1 элементов образуют выборку
+	for i := range n {
+		j := i + randIntN(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n]
+}
+
+// WeightedSample возвращает n элементов xs без повторного выбора, где вероятность выбрать элемент
+// пропорциональна его весу. Элементы с нулевым весом не выбираются, поэтому результат может быть короче n.
+// Число весов должно совпадать с числом элементов, веса должны быть неотрицательными и конечными
+func WeightedSample[T any](xs []T, weights []float64, n int, rng *rand.Rand) ([]T, error) {
+	if len(weights) != len(xs) {
+		return nil, fmt.Errorf("%w: %d weights for %d elements", ErrInvalidWeights, len(weights), len(xs))
+	}
+
+	// Алгоритм Эфраимидиса-Спиракиса: у каждого элемента ключ u^(1/w), берутся n наибольших
+	type keyed struct {
+		index int
+		key   float64
+	}
+	candidates := make([]keyed, 0, len(xs))
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w: weight %d is %v", ErrInvalidWeights, i, w)
+		}
+		if w == 0 {
+			continue
+		}
+		candidates = append(candidates, keyed{index: i, key: math.Pow(randFloat64(rng), 1/w)})
+	}
+	slices.SortFunc(candidates, func(a, b keyed) int {
+		return cmp.Compare(b.key, a.key)
+	})
+
+	candidates = candidates[:clampIndex(n, len(candidates))]
+	result := make([]T, len(candidates))
+	for i, c := range candidates {
+		result[i] = xs[c.index]
+	}
+	return result, nil
+}
+
+func randIntN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
+
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func seeded() *rand.Rand {
+	return rand.New(rand.NewPCG(42, 42))
+}
+
+func TestShuffle(t *testing.T) {
+	t.Parallel()
+
+	a := Range(0, 20, 1)
+	b := Range(0, 20, 1)
+	Shuffle(a, seeded())
+	Shuffle(b, seeded())
+
+	assert.Equal(t, a, b, "same seed gives the same permutation")
+	assert.NotEqual(t, Range(0, 20, 1), a)
+	assert.ElementsMatch(t, Range(0, 20, 1), a)
+
+	c := Range(0, 5, 1)
+	Shuffle(c, nil)
+	assert.ElementsMatch(t, Range(0, 5, 1), c)
+}
+
+func TestSample(t *testing.T) {
+	t.Parallel()
+
+	xs := []string{"a", "b", "c", "d", "e"}
+
+	tests := []struct {
+		name     string
+		n        int
+		expected int
+	}{
+		{"part", 3, 3},
+		{"everything", 5, 5},
+		{"more than available", 10, 5},
+		{"negative", -1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sample := Sample(xs, tt.n, seeded())
+			assert.Len(t, sample, tt.expected)
+			assert.Len(t, Unique(sample), tt.expected, "no element is picked twice")
+			assert.Subset(t, xs, sample)
+		})
+	}
+
+	assert.Equal(t, Sample(xs, 3, seeded()), Sample(xs, 3, seeded()))
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, xs, "input is not modified")
+}
+
+func TestWeightedSample(t *testing.T) {
+	t.Parallel()
+
+	t.Run("follows weights", func(t *testing.T) {
+		t.Parallel()
+		rng := seeded()
+		counts := map[string]int{}
+		for range 10000 {
+			sample, err := WeightedSample([]string{"rare", "common"}, []float64{1, 9}, 1, rng)
+			assert.NoError(t, err)
+			counts[sample[0]]++
+		}
+		assert.InDelta(t, 1000, counts["rare"], 150)
+	})
+
+	t.Run("zero weights are never picked", func(t *testing.T) {
+		t.Parallel()
+		sample, err := WeightedSample([]int{1, 2, 3}, []float64{0, 5, 0}, 3, seeded())
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2}, sample)
+	})
+
+	t.Run("deterministic with seed", func(t *testing.T) {
+		t.Parallel()
+		xs := Range(0, 10, 1)
+		weights := Map(xs, func(x int) float64 { return float64(x + 1) })
+		a, _ := WeightedSample(xs, weights, 4, seeded())
+		b, _ := WeightedSample(xs, weights, 4, seeded())
+		assert.Equal(t, a, b)
+		assert.Len(t, Unique(a), 4)
+	})
+
+	t.Run("invalid weights", func(t *testing.T) {
+		t.Parallel()
+		_, err := WeightedSample([]int{1, 2}, []float64{1}, 1, nil)
+		assert.ErrorIs(t, err, ErrInvalidWeights)
+
+		_, err = WeightedSample([]int{1, 2}, []float64{1, -1}, 1, nil)
+		assert.ErrorIs(t, err, ErrInvalidWeights)
+	})
+}
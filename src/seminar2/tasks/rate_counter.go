@@ -0,0 +1,115 @@
+package tasks
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// RateOption — опция для настройки RateCounter
+type RateOption func(*RateCounter)
+
+// WithClock подменяет источник текущего времени, например в тестах
+func WithClock(now func() time.Time) RateOption {
+	return func(r *RateCounter) {
+		r.now = now
+	}
+}
+
+// WithDecay включает экспоненциальное затухание: событие возраста halfLife
+// учитывается в Rate с весом 1/2, так что недавние события влияют сильнее.
+// Неположительный halfLife отключает затухание
+func WithDecay(halfLife time.Duration) RateOption {
+	return func(r *RateCounter) {
+		r.halfLife = max(halfLife, 0)
+	}
+}
+
+// rateEvent — n событий, записанных в момент at
+type rateEvent struct {
+	at time.Time
+	n  int
+}
+
+// RateCounter считает события в скользящем окне и сообщает их частоту в секунду.
+// Все методы можно вызывать из нескольких горутин
+type RateCounter struct {
+	mu       sync.Mutex
+	window   time.Duration
+	halfLife time.Duration
+	now      func() time.Time
+	events   []rateEvent
+}
+
+// NewRateCounter создает счетчик с окном window.
+// Как и time.NewTicker, паникует, если window не положительно: частоту в таком окне не посчитать
+func NewRateCounter(window time.Duration, options ...RateOption) *RateCounter {
+	if window <= 0 {
+		panic("tasks: non-positive window for NewRateCounter")
+	}
+	r := &RateCounter{window: window, now: time.Now}
+	for _, option := range options {
+		option(r)
+	}
+	return r
+}
+
+// Record записывает одно событие
+func (r *RateCounter) Record() {
+	r.RecordN(1)
+}
+
+// RecordN записывает n событий, произошедших одновременно
+func (r *RateCounter) RecordN(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	r.evict(now)
+	r.events = append(r.events, rateEvent{at: now, n: n})
+}
+
+// Count возвращает количество событий в окне
+func (r *RateCounter) Count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.evict(r.now())
+	count := 0
+	for _, e := range r.events {
+		count += e.n
+	}
+	return count
+}
+
+// Rate возвращает количество событий в секунду за окно, с учетом затухания, если оно включено
+func (r *RateCounter) Rate() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	r.evict(now)
+	total := 0.0
+	for _, e := range r.events {
+		weight := 1.0
+		if r.halfLife > 0 {
+			weight = math.Exp2(-float64(now.Sub(e.at)) / float64(r.halfLife))
+		}
+		total += float64(e.n) * weight
+	}
+	return total / r.window.Seconds()
+}
+
+// Reset забывает все записанные события
+func (r *RateCounter) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+// evict удаляет события, вышедшие из окна
+func (r *RateCounter) evict(now time.Time) {
+	cutoff := now.Add(-r.window)
+	i := 0
+	for i < len(r.events) && !r.events[i].at.After(cutoff) {
+		i++
+	}
+	r.events = r.events[i:]
+}
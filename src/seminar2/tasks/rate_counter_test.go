@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock — управляемые вручную часы для тестов
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestRateCounter(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	r := NewRateCounter(10*time.Second, WithClock(clock.Now))
+
+	for range 5 {
+		r.Record()
+		clock.Advance(time.Second)
+	}
+	r.RecordN(15)
+
+	assert.Equal(t, 20, r.Count())
+	assert.InDelta(t, 2.0, r.Rate(), 1e-9)
+
+	// Первые события выходят из окна
+	clock.Advance(7 * time.Second)
+	assert.Equal(t, 17, r.Count())
+
+	clock.Advance(10 * time.Second)
+	assert.Equal(t, 0, r.Count())
+	assert.Zero(t, r.Rate())
+}
+
+func TestRateCounterReset(t *testing.T) {
+	t.Parallel()
+
+	r := NewRateCounter(time.Minute)
+	r.RecordN(100)
+	r.Reset()
+
+	assert.Equal(t, 0, r.Count())
+	assert.Zero(t, r.Rate())
+}
+
+func TestRateCounterInvalidWindow(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { NewRateCounter(0) })
+	assert.Panics(t, func() { NewRateCounter(-time.Second) })
+}
+
+func TestRateCounterDecay(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	r := NewRateCounter(time.Minute, WithClock(clock.Now), WithDecay(10*time.Second))
+
+	r.RecordN(60)
+	assert.InDelta(t, 1.0, r.Rate(), 1e-9)
+
+	clock.Advance(10 * time.Second)
+	assert.InDelta(t, 0.5, r.Rate(), 1e-9)
+	assert.Equal(t, 60, r.Count(), "decay affects only the rate")
+
+	clock.Advance(10 * time.Second)
+	assert.InDelta(t, 0.25, r.Rate(), 1e-9)
+}
@@ -0,0 +1,37 @@
+package tasks
+
+// Pairwise возвращает пары соседних элементов: (xs[0], xs[1]), (xs[1], xs[2]), ...
+func Pairwise[T any](xs []T) []Pair[T, T] {
+	if len(xs) < 2 {
+		return nil
+	}
+	pairs := make([]Pair[T, T], len(xs)-1)
+	for i := range pairs {
+		pairs[i] = Pair[T, T]{First: xs[i], Second: xs[i+1]}
+	}
+	return pairs
+}
+
+// AdjacentDiff возвращает разности соседних элементов: xs[1]-xs[0], xs[2]-xs[1], ...
+func AdjacentDiff[T Number](xs []T) []T {
+	return Map(Pairwise(xs), func(p Pair[T, T]) T {
+		return p.Second - p.First
+	})
+}
+
+// Scan возвращает промежуточные результаты свертки: i-й элемент равен
+// f(...f(f(init, xs[0]), xs[1])..., xs[i])
+func Scan[T, A any](xs []T, init A, f func(acc A, x T) A) []A {
+	result := make([]A, len(xs))
+	acc := init
+	for i, x := range xs {
+		acc = f(acc, x)
+		result[i] = acc
+	}
+	return result
+}
+
+// Accumulate возвращает префиксные суммы xs
+func Accumulate[T Number](xs []T) []T {
+	return Scan(xs, 0, func(acc, x T) T { return acc + x })
+}
@@ -0,0 +1,53 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPairwise(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []string
+		expected []Pair[string, string]
+	}{
+		{"three elements", []string{"a", "b", "c"}, []Pair[string, string]{{"a", "b"}, {"b", "c"}}},
+		{"single element", []string{"a"}, nil},
+		{"empty input", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Pairwise(tt.input))
+		})
+	}
+}
+
+func TestAdjacentDiff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{2, 3, -4}, AdjacentDiff([]int{1, 3, 6, 2}))
+	assert.Empty(t, AdjacentDiff([]int{5}))
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{1, 3, 6, 10}, Accumulate([]int{1, 2, 3, 4}))
+	assert.Empty(t, Accumulate[float64](nil))
+
+	// Максимум на префиксе
+	prefixMax := Scan([]int{3, 1, 4, 1, 5}, 0, func(acc, x int) int { return max(acc, x) })
+	assert.Equal(t, []int{3, 3, 4, 4, 5}, prefixMax)
+
+	// Сумма на отрезке через префиксные суммы
+	prefix := append([]int{0}, Accumulate([]int{5, 2, 7, 1})...)
+	assert.Equal(t, 9, prefix[3]-prefix[1])
+
+	lines := Scan([]string{"a", "b"}, ">", func(acc, x string) string { return acc + x })
+	assert.Equal(t, []string{">a", ">ab"}, lines)
+}
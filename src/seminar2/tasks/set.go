@@ -0,0 +1,130 @@
+package tasks
+
+import "iter"
+
+// Set — множество сравнимых элементов на основе хеш-таблицы. Нулевое значение готово к использованию
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet создает множество из перечисленных элементов
+func NewSet[T comparable](items ...T) *Set[T] {
+	return FromSlice(items)
+}
+
+// FromSlice создает множество из элементов слайса
+func FromSlice[T comparable](xs []T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(xs))}
+	s.Add(xs...)
+	return s
+}
+
+// FromKeys создает множество из ключей хеш-таблицы
+func FromKeys[T comparable, V any](m map[T]V) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(m))}
+	for k := range m {
+		s.items[k] = struct{}{}
+	}
+	return s
+}
+
+// Add добавляет элементы
+func (s *Set[T]) Add(items ...T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{}, len(items))
+	}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+}
+
+// Remove удаляет элемент и сообщает, был ли он в множестве
+func (s *Set[T]) Remove(item T) bool {
+	_, ok := s.items[item]
+	delete(s.items, item)
+	return ok
+}
+
+// Contains сообщает, входит ли элемент в множество
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len возвращает количество элементов
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// All перечисляет элементы в произвольном порядке
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Clone возвращает независимую копию множества
+func (s *Set[T]) Clone() *Set[T] {
+	return s.filter(func(T) bool { return true })
+}
+
+// Union возвращает новое множество из элементов s и other
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for item := range other.items {
+		result.items[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersect возвращает новое множество из элементов, входящих и в s, и в other
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	return s.filter(other.Contains)
+}
+
+// Difference возвращает новое множество из элементов s, не входящих в other
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return s.filter(func(item T) bool { return !other.Contains(item) })
+}
+
+// SymmetricDifference возвращает новое множество из элементов, входящих ровно в одно из множеств
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubset сообщает, входит ли каждый элемент s в other
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset сообщает, входит ли каждый элемент other в s
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal сообщает, состоят ли множества из одних и тех же элементов
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Set[T]) filter(keep func(T) bool) *Set[T] {
+	result := &Set[T]{items: make(map[T]struct{})}
+	for item := range s.items {
+		if keep(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package tasks
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Union возвращает без повторов элементы, входящие в a или b:
+// сначала элементы a, затем недостающие элементы b, в порядке первого появления
+func Union[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	var result []T
+	for _, xs := range [][]T{a, b} {
+		for _, x := range xs {
+			if _, ok := seen[x]; !ok {
+				seen[x] = struct{}{}
+				result = append(result, x)
+			}
+		}
+	}
+	return result
+}
+
+// Intersection возвращает без повторов элементы a, входящие и в b, в порядке их появления в a
+func Intersection[T comparable](a, b []T) []T {
+	return selectFrom(a, toSet(b), true)
+}
+
+// Difference возвращает без повторов элементы a, не входящие в b, в порядке их появления в a
+func Difference[T comparable](a, b []T) []T {
+	return selectFrom(a, toSet(b), false)
+}
+
+// SymmetricDifference возвращает без повторов элементы, входящие ровно в один из слайсов:
+// сначала из a, затем из b, в порядке появления
+func SymmetricDifference[T comparable](a, b []T) []T {
+	return append(Difference(a, b), Difference(b, a)...)
+}
+
+// UnionSorted — Union с результатом, отсортированным по возрастанию
+func UnionSorted[T cmp.Ordered](a, b []T) []T {
+	return sorted(Union(a, b))
+}
+
+// IntersectionSorted — Intersection с результатом, отсортированным по возрастанию
+func IntersectionSorted[T cmp.Ordered](a, b []T) []T {
+	return sorted(Intersection(a, b))
+}
+
+// DifferenceSorted — Difference с результатом, отсортированным по возрастанию
+func DifferenceSorted[T cmp.Ordered](a, b []T) []T {
+	return sorted(Difference(a, b))
+}
+
+// SymmetricDifferenceSorted — SymmetricDifference с результатом, отсортированным по возрастанию
+func SymmetricDifferenceSorted[T cmp.Ordered](a, b []T) []T {
+	return sorted(SymmetricDifference(a, b))
+}
+
+// selectFrom возвращает без повторов элементы xs, наличие которых в set равно want
+func selectFrom[T comparable](xs []T, set map[T]struct{}, want bool) []T {
+	seen := make(map[T]struct{}, len(xs))
+	var result []T
+	for _, x := range xs {
+		if _, ok := seen[x]; ok {
+			continue
+		}
+		seen[x] = struct{}{}
+		if _, ok := set[x]; ok == want {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+func toSet[T comparable](xs []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(xs))
+	for _, x := range xs {
+		set[x] = struct{}{}
+	}
+	return set
+}
+
+func sorted[T cmp.Ordered](xs []T) []T {
+	slices.Sort(xs)
+	return xs
+}
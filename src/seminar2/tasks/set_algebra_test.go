@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAlgebra(t *testing.T) {
+	t.Parallel()
+
+	a := []int{5, 1, 3, 1, 7}
+	b := []int{3, 9, 5, 2, 9}
+
+	tests := []struct {
+		name     string
+		op       func(a, b []int) []int
+		expected []int
+	}{
+		{"union", Union[int], []int{5, 1, 3, 7, 9, 2}},
+		{"intersection", Intersection[int], []int{5, 3}},
+		{"difference", Difference[int], []int{1, 7}},
+		{"symmetric difference", SymmetricDifference[int], []int{1, 7, 9, 2}},
+		{"union sorted", UnionSorted[int], []int{1, 2, 3, 5, 7, 9}},
+		{"intersection sorted", IntersectionSorted[int], []int{3, 5}},
+		{"difference sorted", DifferenceSorted[int], []int{1, 7}},
+		{"symmetric difference sorted", SymmetricDifferenceSorted[int], []int{1, 2, 7, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.op(a, b))
+		})
+	}
+
+	assert.Equal(t, []int{5, 1, 3, 1, 7}, a, "inputs are not modified")
+}
+
+func TestSetAlgebraEmpty(t *testing.T) {
+	t.Parallel()
+
+	xs := []string{"a", "b", "a"}
+
+	assert.Equal(t, []string{"a", "b"}, Union(xs, nil))
+	assert.Nil(t, Intersection(xs, nil))
+	assert.Equal(t, []string{"a", "b"}, Difference(xs, nil))
+	assert.Nil(t, Difference(nil, xs))
+	assert.Equal(t, []string{"a", "b"}, SymmetricDifference(nil, xs))
+	assert.Nil(t, UnionSorted[string](nil, nil))
+}
@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetBasics(t *testing.T) {
+	t.Parallel()
+
+	s := NewSet(1, 2, 2, 3)
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Contains(2))
+	assert.False(t, s.Contains(4))
+
+	s.Add(4, 1)
+	assert.Equal(t, 4, s.Len())
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Remove(1))
+	assert.ElementsMatch(t, []int{2, 3, 4}, slices.Collect(s.All()))
+
+	var zero Set[string]
+	assert.False(t, zero.Contains("a"))
+	assert.False(t, zero.Remove("a"))
+	zero.Add("a")
+	assert.Equal(t, 1, zero.Len())
+}
+
+func TestSetConstructors(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, FromSlice([]string{"a", "b", "a"}).Equal(NewSet("b", "a")))
+	assert.True(t, FromKeys(map[string]int{"x": 1, "y": 2}).Equal(NewSet("x", "y")))
+	assert.Equal(t, 0, FromSlice[int](nil).Len())
+}
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+
+	tests := []struct {
+		name     string
+		result   *Set[int]
+		expected []int
+	}{
+		{"union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"intersect", a.Intersect(b), []int{3, 4}},
+		{"difference", a.Difference(b), []int{1, 2}},
+		{"symmetric difference", a.SymmetricDifference(b), []int{1, 2, 5}},
+		{"with empty", a.Intersect(NewSet[int]()), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ElementsMatch(t, tt.expected, slices.Collect(tt.result.All()))
+		})
+	}
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, slices.Collect(a.All()), "operands are not modified")
+}
+
+func TestSetSubset(t *testing.T) {
+	t.Parallel()
+
+	small := NewSet("a", "b")
+	big := NewSet("a", "b", "c")
+
+	assert.True(t, small.IsSubset(big))
+	assert.False(t, big.IsSubset(small))
+	assert.True(t, big.IsSuperset(small))
+	assert.True(t, small.IsSubset(small))
+	assert.True(t, NewSet[string]().IsSubset(small))
+	assert.False(t, NewSet("a", "z").IsSubset(big))
+	assert.False(t, small.Equal(big))
+
+	clone := big.Clone()
+	clone.Add("d")
+	assert.Equal(t, 3, big.Len())
+}
@@ -0,0 +1,53 @@
+package tasks
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortBy сортирует xs на месте по возрастанию ключа key; порядок равных элементов не гарантируется
+func SortBy[T any, K cmp.Ordered](xs []T, key func(T) K) {
+	slices.SortFunc(xs, CompareBy(key))
+}
+
+// SortStableBy сортирует xs на месте по возрастанию ключа key, сохраняя порядок равных элементов
+func SortStableBy[T any, K cmp.Ordered](xs []T, key func(T) K) {
+	slices.SortStableFunc(xs, CompareBy(key))
+}
+
+// SortByFuncs устойчиво сортирует xs на месте по нескольким компараторам:
+// следующий компаратор учитывается, только если все предыдущие сочли элементы равными
+func SortByFuncs[T any](xs []T, cmps ...func(a, b T) int) {
+	slices.SortStableFunc(xs, chain(cmps))
+}
+
+// IsSortedBy сообщает, упорядочен ли xs по неубыванию ключа key
+func IsSortedBy[T any, K cmp.Ordered](xs []T, key func(T) K) bool {
+	return slices.IsSortedFunc(xs, CompareBy(key))
+}
+
+// CompareBy возвращает компаратор, сравнивающий элементы по ключу key
+func CompareBy[T any, K cmp.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Descending обращает порядок компаратора
+func Descending[T any](compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return compare(b, a)
+	}
+}
+
+// chain объединяет компараторы в лексикографический
+func chain[T any](cmps []func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		for _, compare := range cmps {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
@@ -0,0 +1,83 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type student struct {
+	name  string
+	group int
+	score float64
+}
+
+func students() []student {
+	return []student{
+		{"eve", 2, 4.5},
+		{"ann", 1, 4.5},
+		{"bob", 2, 3.9},
+		{"dan", 1, 5.0},
+		{"cat", 2, 4.5},
+	}
+}
+
+func names(xs []student) []string {
+	return Map(xs, func(s student) string { return s.name })
+}
+
+func TestSortBy(t *testing.T) {
+	t.Parallel()
+
+	xs := students()
+	SortBy(xs, func(s student) string { return s.name })
+	assert.Equal(t, []string{"ann", "bob", "cat", "dan", "eve"}, names(xs))
+}
+
+func TestSortStableBy(t *testing.T) {
+	t.Parallel()
+
+	xs := students()
+	SortStableBy(xs, func(s student) int { return s.group })
+	assert.Equal(t, []string{"ann", "dan", "eve", "bob", "cat"}, names(xs))
+}
+
+func TestSortByFuncs(t *testing.T) {
+	t.Parallel()
+
+	byGroup := CompareBy(func(s student) int { return s.group })
+	byScore := CompareBy(func(s student) float64 { return s.score })
+	byName := CompareBy(func(s student) string { return s.name })
+
+	tests := []struct {
+		name     string
+		cmps     []func(a, b student) int
+		expected []string
+	}{
+		{"group then name", []func(a, b student) int{byGroup, byName}, []string{"ann", "dan", "bob", "cat", "eve"}},
+		{"score descending then name", []func(a, b student) int{Descending(byScore), byName}, []string{"dan", "ann", "cat", "eve", "bob"}},
+		{"ties keep input order", []func(a, b student) int{byScore}, []string{"bob", "eve", "ann", "cat", "dan"}},
+		{"no comparators", nil, []string{"eve", "ann", "bob", "dan", "cat"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			xs := students()
+			SortByFuncs(xs, tt.cmps...)
+			assert.Equal(t, tt.expected, names(xs))
+		})
+	}
+}
+
+func TestIsSortedBy(t *testing.T) {
+	t.Parallel()
+
+	byGroup := func(s student) int { return s.group }
+	xs := students()
+	assert.False(t, IsSortedBy(xs, byGroup))
+
+	SortStableBy(xs, byGroup)
+	assert.True(t, IsSortedBy(xs, byGroup))
+	assert.True(t, IsSortedBy(nil, byGroup))
+}
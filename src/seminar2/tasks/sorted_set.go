@@ -0,0 +1,81 @@
+package tasks
+
+import (
+	"cmp"
+	"iter"
+)
+
+// SortedSet — упорядоченное множество на АВЛ-дереве с порядковыми статистиками:
+// кроме добавления и поиска за O(log n) умеет находить позицию элемента и элемент по позиции
+type SortedSet[T any] struct {
+	tree *TreeMap[T, struct{}]
+}
+
+// NewSortedSet создает множество с естественным порядком и добавляет в него items
+func NewSortedSet[T cmp.Ordered](items ...T) *SortedSet[T] {
+	return NewSortedSetFunc(cmp.Compare[T], items...)
+}
+
+// NewSortedSetFunc создает множество с порядком, заданным функцией сравнения cmp, и добавляет в него items
+func NewSortedSetFunc[T any](cmp func(a, b T) int, items ...T) *SortedSet[T] {
+	s := &SortedSet[T]{tree: NewTreeMapFunc[T, struct{}](cmp)}
+	for _, item := range items {
+		s.Add(item)
+	}
+	return s
+}
+
+// Add добавляет элемент и сообщает, не было ли его раньше
+func (s *SortedSet[T]) Add(item T) bool {
+	if s.Contains(item) {
+		return false
+	}
+	s.tree.Put(item, struct{}{})
+	return true
+}
+
+// Remove удаляет элемент и сообщает, был ли он в множестве
+func (s *SortedSet[T]) Remove(item T) bool {
+	return s.tree.Delete(item)
+}
+
+// Contains сообщает, входит ли элемент в множество
+func (s *SortedSet[T]) Contains(item T) bool {
+	_, ok := s.tree.Get(item)
+	return ok
+}
+
+// Len возвращает количество элементов
+func (s *SortedSet[T]) Len() int {
+	return s.tree.Len()
+}
+
+// Rank возвращает количество элементов, меньших x; для элемента множества это его позиция с нуля
+func (s *SortedSet[T]) Rank(x T) int {
+	return s.tree.rank(x)
+}
+
+// Kth возвращает i-й по возрастанию элемент (с нуля); ok == false, если i вне [0, Len())
+func (s *SortedSet[T]) Kth(i int) (item T, ok bool) {
+	n := s.tree.kth(i)
+	if n == nil {
+		return item, false
+	}
+	return n.key, true
+}
+
+// All перечисляет элементы по возрастанию
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return s.tree.Keys()
+}
+
+// Range перечисляет по возрастанию элементы из отрезка [lo, hi]
+func (s *SortedSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.tree.Range(lo, hi) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
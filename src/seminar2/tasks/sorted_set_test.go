@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSet(t *testing.T) {
+	t.Parallel()
+
+	s := NewSortedSet(50, 10, 40, 20, 30)
+	assert.False(t, s.Add(30))
+	assert.True(t, s.Add(35))
+	assert.Equal(t, 6, s.Len())
+	assert.True(t, s.Contains(35))
+	assert.Equal(t, []int{10, 20, 30, 35, 40, 50}, slices.Collect(s.All()))
+	assert.Equal(t, []int{20, 30, 35}, slices.Collect(s.Range(15, 35)))
+
+	assert.True(t, s.Remove(35))
+	assert.False(t, s.Remove(35))
+	assert.False(t, s.Contains(35))
+}
+
+func TestSortedSetOrderStatistics(t *testing.T) {
+	t.Parallel()
+
+	s := NewSortedSet(50, 10, 40, 20, 30)
+
+	tests := []struct {
+		name string
+		x    int
+		rank int
+	}{
+		{"smallest", 10, 0},
+		{"member", 40, 3},
+		{"between members", 25, 2},
+		{"below all", 0, 0},
+		{"above all", 99, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.rank, s.Rank(tt.x))
+		})
+	}
+
+	for i, expected := range []int{10, 20, 30, 40, 50} {
+		got, ok := s.Kth(i)
+		assert.True(t, ok)
+		assert.Equal(t, expected, got)
+	}
+	_, ok := s.Kth(5)
+	assert.False(t, ok)
+	_, ok = s.Kth(-1)
+	assert.False(t, ok)
+}
+
+func TestSortedSetLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	type score struct {
+		name   string
+		points int
+	}
+	byPointsDesc := func(a, b score) int {
+		return cmp.Or(cmp.Compare(b.points, a.points), cmp.Compare(a.name, b.name))
+	}
+
+	board := NewSortedSetFunc(byPointsDesc, score{"ann", 70}, score{"bob", 90}, score{"eve", 70})
+	board.Add(score{"dan", 80})
+
+	leader, ok := board.Kth(0)
+	assert.True(t, ok)
+	assert.Equal(t, "bob", leader.name)
+	assert.Equal(t, 2, board.Rank(score{"ann", 70}))
+}
+
+func TestSortedSetMatchesSortedSlice(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(3, 4))
+	s := NewSortedSet[int]()
+	reference := make(map[int]struct{})
+	for range 3000 {
+		x := rng.IntN(500)
+		if rng.IntN(3) == 0 {
+			s.Remove(x)
+			delete(reference, x)
+		} else {
+			s.Add(x)
+			reference[x] = struct{}{}
+		}
+	}
+
+	var expected []int
+	for x := range reference {
+		expected = append(expected, x)
+	}
+	slices.Sort(expected)
+
+	assert.Equal(t, expected, slices.Collect(s.All()))
+	for i, x := range expected {
+		assert.Equal(t, i, s.Rank(x))
+		got, _ := s.Kth(i)
+		assert.Equal(t, x, got)
+	}
+}
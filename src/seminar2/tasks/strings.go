@@ -0,0 +1,128 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidEncoding возвращается RunLengthDecode для строки, которую не мог построить RunLengthEncode
+var ErrInvalidEncoding = errors.New("invalid run-length encoding")
+
+// WordFrequencies считает, сколько раз встречается каждое слово текста без учета регистра.
+// Словом считается последовательность букв и цифр
+func WordFrequencies(text string) map[string]int {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return CountBy(words, strings.ToLower)
+}
+
+// ReverseWords переставляет слова строки в обратном порядке, разделяя их одним пробелом
+func ReverseWords(s string) string {
+	words := strings.Fields(s)
+	Reverse(words)
+	return strings.Join(words, " ")
+}
+
+// IsPalindrome проверяет, читается ли строка одинаково в обе стороны,
+// учитывая только буквы и цифры любого алфавита и не различая регистр
+func IsPalindrome(s string) bool {
+	var runes []rune
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes = append(runes, unicode.ToLower(r))
+		}
+	}
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		if runes[i] != runes[j] {
+			return false
+		}
+	}
+	return true
+}
+
+// Caesar сдвигает латинские буквы строки на shift позиций по алфавиту с сохранением регистра.
+// Отрицательный shift сдвигает назад, остальные символы не меняются
+func Caesar(s string, shift int) string {
+	shift = ((shift % 26) + 26) % 26
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return 'a' + (r-'a'+rune(shift))%26
+		case r >= 'A' && r <= 'Z':
+			return 'A' + (r-'A'+rune(shift))%26
+		default:
+			return r
+		}
+	}, s)
+}
+
+// ROT13 — шифр Цезаря со сдвигом 13; повторное применение возвращает исходную строку
+func ROT13(s string) string {
+	return Caesar(s, 13)
+}
+
+// MaxRunLength — наибольшая длина серии в кодировке RunLengthEncode; более длинные серии разбиваются на части,
+// а RunLengthDecode отвергает такие длины, чтобы короткий вход не требовал огромной памяти
+const MaxRunLength = 1 << 16
+
+// rleEscape экранирует в кодировке RunLengthEncode цифры и сам себя, чтобы их не путать с длинами серий
+const rleEscape = '\\'
+
+// RunLengthEncode сжимает повторы символов: каждая серия записывается символом и длиной, "aaab" -> "a3b1".
+// Цифры и обратная косая черта экранируются обратной косой чертой, "a11" -> `a1\12`,
+// поэтому RunLengthDecode восстанавливает любую строку
+func RunLengthEncode(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		j := i
+		for j < len(runes) && runes[j] == runes[i] && j-i < MaxRunLength {
+			j++
+		}
+		if runes[i] == rleEscape || (runes[i] >= '0' && runes[i] <= '9') {
+			b.WriteRune(rleEscape)
+		}
+		b.WriteRune(runes[i])
+		b.WriteString(strconv.Itoa(j - i))
+		i = j
+	}
+	return b.String()
+}
+
+// RunLengthDecode восстанавливает строку, сжатую RunLengthEncode.
+// Длина каждой серии должна лежать в [1, MaxRunLength], иначе возвращается ErrInvalidEncoding
+func RunLengthDecode(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		if r == rleEscape {
+			if i == len(s) {
+				return "", fmt.Errorf("%w: dangling escape at byte %d", ErrInvalidEncoding, i-size)
+			}
+			r, size = utf8.DecodeRuneInString(s[i:])
+			i += size
+		}
+
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if start == i {
+			return "", fmt.Errorf("%w: no count after %q at byte %d", ErrInvalidEncoding, r, start)
+		}
+		count, err := strconv.Atoi(s[start:i])
+		if err != nil || count < 1 || count > MaxRunLength {
+			return "", fmt.Errorf("%w: count %s for %q must be in [1, %d]", ErrInvalidEncoding, s[start:i], r, MaxRunLength)
+		}
+		for range count {
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
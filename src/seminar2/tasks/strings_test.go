@@ -0,0 +1,153 @@
+package tasks
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordFrequencies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]int
+	}{
+		{"punctuation and case", "The cat, the dog. THE END!", map[string]int{"the": 3, "cat": 1, "dog": 1, "end": 1}},
+		{"unicode words", "Мама мыла раму, мама!", map[string]int{"мама": 2, "мыла": 1, "раму": 1}},
+		{"empty input", "  ...  ", map[string]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, WordFrequencies(tt.input))
+		})
+	}
+}
+
+func TestReverseWords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"simple", "hello big world", "world big hello"},
+		{"extra spaces", "  a   b ", "b a"},
+		{"single word", "go", "go"},
+		{"empty input", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, ReverseWords(tt.input))
+		})
+	}
+}
+
+func TestIsPalindrome(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"simple", "racecar", true},
+		{"phrase with punctuation", "A man, a plan, a canal: Panama", true},
+		{"cyrillic", "А роза упала на лапу Азора", true},
+		{"not a palindrome", "hello", false},
+		{"empty input", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, IsPalindrome(tt.input))
+		})
+	}
+}
+
+func TestCaesar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		shift    int
+		expected string
+	}{
+		{"shift three", "abc xyz", 3, "def abc"},
+		{"keeps case and symbols", "Hello, World!", 1, "Ifmmp, Xpsme!"},
+		{"negative shift", "def", -3, "abc"},
+		{"large shift", "a", 27, "b"},
+		{"non-latin untouched", "привет", 5, "привет"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Caesar(tt.input, tt.shift))
+		})
+	}
+
+	assert.Equal(t, "Uryyb", ROT13("Hello"))
+	assert.Equal(t, "Hello", ROT13(ROT13("Hello")))
+}
+
+func TestRunLength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		encoded string
+	}{
+		{"runs", "aaabccdddd", "a3b1c2d4"},
+		{"no repeats", "abc", "a1b1c1"},
+		{"long run", "zzzzzzzzzzzz", "z12"},
+		{"unicode", "ййжж ", "й2ж2 1"},
+		{"digits", "a1", `a1\11`},
+		{"repeated digits", "0007", `\03\71`},
+		{"escape character", `x\\`, `x1\\2`},
+		{"empty input", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.encoded, RunLengthEncode(tt.input))
+
+			decoded, err := RunLengthDecode(tt.encoded)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input, decoded)
+		})
+	}
+}
+
+func TestRunLengthDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"a", "ab2", `a1\`, `\`, "a0", "b1a00", "a65537", "a99999999999", "a99999999999999999999"} {
+		_, err := RunLengthDecode(input)
+		assert.ErrorIs(t, err, ErrInvalidEncoding, input)
+	}
+}
+
+func TestRunLengthLongRun(t *testing.T) {
+	t.Parallel()
+
+	input := strings.Repeat("x", MaxRunLength+5)
+	encoded := RunLengthEncode(input)
+	assert.Equal(t, "x"+strconv.Itoa(MaxRunLength)+"x5", encoded)
+
+	decoded, err := RunLengthDecode(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, input, decoded)
+}
@@ -0,0 +1,75 @@
+package tasks
+
+import "sync"
+
+// SyncMap — типизированная обертка над sync.Map, избавляющая от приведений из any.
+// Нулевое значение готово к использованию, все методы можно вызывать из нескольких горутин
+type SyncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Load возвращает значение по ключу и признак его наличия
+func (m *SyncMap[K, V]) Load(key K) (V, bool) {
+	v, ok := m.m.Load(key)
+	return typed[V](v), ok
+}
+
+// Store записывает значение по ключу
+func (m *SyncMap[K, V]) Store(key K, value V) {
+	m.m.Store(key, value)
+}
+
+// LoadOrStore возвращает существующее значение ключа (loaded == true) или сохраняет и возвращает value
+func (m *SyncMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	v, loaded := m.m.LoadOrStore(key, value)
+	return typed[V](v), loaded
+}
+
+// LoadAndDelete удаляет ключ и возвращает его прежнее значение
+func (m *SyncMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	v, loaded := m.m.LoadAndDelete(key)
+	return typed[V](v), loaded
+}
+
+// Delete удаляет ключ
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// Swap записывает value и возвращает прежнее значение ключа, если оно было
+func (m *SyncMap[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	v, loaded := m.m.Swap(key, value)
+	return typed[V](v), loaded
+}
+
+// CompareAndSwap заменяет значение ключа на new, если текущее значение равно old.
+// Как и у sync.Map, значения должны быть сравнимы, иначе метод паникует
+func (m *SyncMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	return m.m.CompareAndSwap(key, old, new)
+}
+
+// CompareAndDelete удаляет ключ, если его значение равно old
+func (m *SyncMap[K, V]) CompareAndDelete(key K, old V) bool {
+	return m.m.CompareAndDelete(key, old)
+}
+
+// Range вызывает f для каждой пары, пока f возвращает true; гарантии согласованности те же, что у sync.Map.Range
+func (m *SyncMap[K, V]) Range(f func(K, V) bool) {
+	m.m.Range(func(k, v any) bool {
+		return f(k.(K), typed[V](v))
+	})
+}
+
+// Clear удаляет все ключи
+func (m *SyncMap[K, V]) Clear() {
+	m.m.Clear()
+}
+
+// typed приводит значение из sync.Map к V; отсутствующее значение (nil) становится нулевым значением V
+func typed[V any](v any) V {
+	if v == nil {
+		var zero V
+		return zero
+	}
+	return v.(V)
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncMap(t *testing.T) {
+	t.Parallel()
+
+	var m SyncMap[string, int]
+	_, ok := m.Load("a")
+	assert.False(t, ok)
+
+	m.Store("a", 1)
+	v, ok := m.Load("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	actual, loaded := m.LoadOrStore("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, actual)
+	actual, loaded = m.LoadOrStore("b", 2)
+	assert.False(t, loaded)
+	assert.Equal(t, 2, actual)
+
+	prev, loaded := m.Swap("b", 20)
+	assert.True(t, loaded)
+	assert.Equal(t, 2, prev)
+
+	v, loaded = m.LoadAndDelete("b")
+	assert.True(t, loaded)
+	assert.Equal(t, 20, v)
+	v, loaded = m.LoadAndDelete("b")
+	assert.False(t, loaded)
+	assert.Equal(t, 0, v)
+
+	m.Delete("a")
+	_, ok = m.Load("a")
+	assert.False(t, ok)
+}
+
+func TestSyncMapCompare(t *testing.T) {
+	t.Parallel()
+
+	var m SyncMap[string, string]
+	m.Store("state", "new")
+
+	assert.False(t, m.CompareAndSwap("state", "done", "archived"))
+	assert.True(t, m.CompareAndSwap("state", "new", "done"))
+	v, _ := m.Load("state")
+	assert.Equal(t, "done", v)
+
+	assert.False(t, m.CompareAndDelete("state", "new"))
+	assert.True(t, m.CompareAndDelete("state", "done"))
+	_, ok := m.Load("state")
+	assert.False(t, ok)
+}
+
+func TestSyncMapRange(t *testing.T) {
+	t.Parallel()
+
+	var m SyncMap[int, string]
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Store(i, "v")
+		}()
+	}
+	wg.Wait()
+
+	keys := 0
+	m.Range(func(k int, v string) bool {
+		keys++
+		assert.Equal(t, "v", v)
+		return true
+	})
+	assert.Equal(t, 50, keys)
+
+	m.Clear()
+	m.Range(func(int, string) bool {
+		t.Fatal("map must be empty after Clear")
+		return false
+	})
+}
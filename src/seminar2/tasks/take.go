@@ -0,0 +1,63 @@
+package tasks
+
+// Take возвращает первые n элементов xs (все, если их меньше).
+// Результаты Take, Drop и их вариантов — подслайсы xs, использующие ту же память
+func Take[T any](xs []T, n int) []T {
+	return xs[:clampIndex(n, len(xs))]
+}
+
+// Drop возвращает xs без первых n элементов
+func Drop[T any](xs []T, n int) []T {
+	return xs[clampIndex(n, len(xs)):]
+}
+
+// TakeLast возвращает последние n элементов xs
+func TakeLast[T any](xs []T, n int) []T {
+	return xs[len(xs)-clampIndex(n, len(xs)):]
+}
+
+// DropLast возвращает xs без последних n элементов
+func DropLast[T any](xs []T, n int) []T {
+	return xs[:len(xs)-clampIndex(n, len(xs))]
+}
+
+// TakeWhile возвращает самый длинный префикс xs, все элементы которого удовлетворяют predicate
+func TakeWhile[T any](xs []T, predicate func(T) bool) []T {
+	return xs[:prefixLen(xs, predicate)]
+}
+
+// DropWhile возвращает xs без самого длинного префикса, удовлетворяющего predicate
+func DropWhile[T any](xs []T, predicate func(T) bool) []T {
+	return xs[prefixLen(xs, predicate):]
+}
+
+// TakeLastWhile возвращает самый длинный суффикс xs, все элементы которого удовлетворяют predicate
+func TakeLastWhile[T any](xs []T, predicate func(T) bool) []T {
+	return xs[len(xs)-suffixLen(xs, predicate):]
+}
+
+// DropLastWhile возвращает xs без самого длинного суффикса, удовлетворяющего predicate
+func DropLastWhile[T any](xs []T, predicate func(T) bool) []T {
+	return xs[:len(xs)-suffixLen(xs, predicate)]
+}
+
+// clampIndex приводит n к отрезку [0, length]
+func clampIndex(n, length int) int {
+	return min(max(n, 0), length)
+}
+
+func prefixLen[T any](xs []T, predicate func(T) bool) int {
+	n := 0
+	for n < len(xs) && predicate(xs[n]) {
+		n++
+	}
+	return n
+}
+
+func suffixLen[T any](xs []T, predicate func(T) bool) int {
+	n := 0
+	for n < len(xs) && predicate(xs[len(xs)-1-n]) {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTakeDrop(t *testing.T) {
+	t.Parallel()
+
+	xs := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name     string
+		op       func([]int, int) []int
+		n        int
+		expected []int
+	}{
+		{"take", Take[int], 2, []int{1, 2}},
+		{"take more than length", Take[int], 10, []int{1, 2, 3, 4, 5}},
+		{"take negative", Take[int], -1, []int{}},
+		{"drop", Drop[int], 2, []int{3, 4, 5}},
+		{"drop everything", Drop[int], 10, []int{}},
+		{"take last", TakeLast[int], 2, []int{4, 5}},
+		{"take last zero", TakeLast[int], 0, []int{}},
+		{"drop last", DropLast[int], 2, []int{1, 2, 3}},
+		{"drop last negative", DropLast[int], -3, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.op(xs, tt.n))
+		})
+	}
+}
+
+func TestTakeDropWhile(t *testing.T) {
+	t.Parallel()
+
+	// Строка, разобранная на токены: пробелы по краям нужно отрезать
+	tokens := []string{" ", " ", "let", "x", " ", "=", "1", " "}
+	isSpace := func(s string) bool { return s == " " }
+	notSpace := func(s string) bool { return s != " " }
+
+	tests := []struct {
+		name     string
+		op       func([]string, func(string) bool) []string
+		pred     func(string) bool
+		expected []string
+	}{
+		{"take while", TakeWhile[string], isSpace, []string{" ", " "}},
+		{"drop while", DropWhile[string], isSpace, []string{"let", "x", " ", "=", "1", " "}},
+		{"take last while", TakeLastWhile[string], isSpace, []string{" "}},
+		{"drop last while", DropLastWhile[string], isSpace, []string{" ", " ", "let", "x", " ", "=", "1"}},
+		{"take while nothing matches", TakeWhile[string], notSpace, []string{}},
+		{"drop last while nothing matches", DropLastWhile[string], notSpace, tokens},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.op(tokens, tt.pred))
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package tasks
+
+import (
+	"cmp"
+	"iter"
+)
+
+// TreeMap — упорядоченная по ключам таблица на АВЛ-дереве.
+// Поиск, вставка и удаление работают за O(log n), ключи перечисляются по возрастанию
+type TreeMap[K, V any] struct {
+	root *treeNode[K, V]
+	size int
+	cmp  func(a, b K) int
+}
+
+type treeNode[K, V any] struct {
+	key         K
+	value       V
+	left, right *treeNode[K, V]
+	height      int
+	size        int // количество узлов в поддереве, нужно для порядковых статистик
+}
+
+// NewTreeMap создает таблицу с естественным порядком ключей
+func NewTreeMap[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewTreeMapFunc создает таблицу с порядком ключей, заданным функцией сравнения cmp
+func NewTreeMapFunc[K, V any](cmp func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{cmp: cmp}
+}
+
+// Len возвращает количество ключей
+func (m *TreeMap[K, V]) Len() int {
+	return m.size
+}
+
+// Get возвращает значение по ключу и признак его наличия
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	for n := m.root; n != nil; {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Put записывает значение по ключу, заменяя прежнее
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	var added bool
+	m.root, added = m.insert(m.root, key, value)
+	if added {
+		m.size++
+	}
+}
+
+// Delete удаляет ключ и сообщает, был ли он в таблице
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	var removed bool
+	m.root, removed = m.remove(m.root, key)
+	if removed {
+		m.size--
+	}
+	return removed
+}
+
+// Min возвращает пару с наименьшим ключом; ok == false для пустой таблицы
+func (m *TreeMap[K, V]) Min() (key K, value V, ok bool) {
+	if m.root == nil {
+		return key, value, false
+	}
+	n := minNode(m.root)
+	return n.key, n.value, true
+}
+
+// Max возвращает пару с наибольшим ключом; ok == false для пустой таблицы
+func (m *TreeMap[K, V]) Max() (key K, value V, ok bool) {
+	if m.root == nil {
+		return key, value, false
+	}
+	n := m.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Floor возвращает пару с наибольшим ключом, не превосходящим key
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var best *treeNode[K, V]
+	for n := m.root; n != nil; {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best, n = n, n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	return nodeEntry(best)
+}
+
+// Ceiling возвращает пару с наименьшим ключом, не меньшим key
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *treeNode[K, V]
+	for n := m.root; n != nil; {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			best, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	return nodeEntry(best)
+}
+
+// All перечисляет пары по возрастанию ключей
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walk(m.root, nil, nil, yield)
+	}
+}
+
+// Keys перечисляет ключи по возрастанию
+func (m *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Range перечисляет по возрастанию пары с ключами из отрезка [lo, hi]
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walk(m.root, &lo, &hi, yield)
+	}
+}
+
+// walk обходит поддерево по возрастанию, пропуская ветки вне [lo, hi]; nil-граница не ограничивает обход.
+// Возвращает false, если yield попросил остановиться
+func (m *TreeMap[K, V]) walk(n *treeNode[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || m.cmp(n.key, *lo) >= 0
+	belowHi := hi == nil || m.cmp(n.key, *hi) <= 0
+	if aboveLo && !m.walk(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return m.walk(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+// rank возвращает количество ключей, меньших key
+func (m *TreeMap[K, V]) rank(key K) int {
+	rank := 0
+	for n := m.root; n != nil; {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += subtreeSize(n.left) + 1
+			n = n.right
+		default:
+			return rank + subtreeSize(n.left)
+		}
+	}
+	return rank
+}
+
+// kth возвращает узел с i-м по возрастанию ключом (с нуля) или nil, если i вне диапазона
+func (m *TreeMap[K, V]) kth(i int) *treeNode[K, V] {
+	if i < 0 || i >= m.size {
+		return nil
+	}
+	n := m.root
+	for {
+		switch left := subtreeSize(n.left); {
+		case i < left:
+			n = n.left
+		case i > left:
+			i -= left + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+func (m *TreeMap[K, V]) insert(n *treeNode[K, V], key K, value V) (*treeNode[K, V], bool) {
+	if n == nil {
+		return &treeNode[K, V]{key: key, value: value, height: 1, size: 1}, true
+	}
+	var added bool
+	switch c := m.cmp(key, n.key); {
+	case c < 0:
+		n.left, added = m.insert(n.left, key, value)
+	case c > 0:
+		n.right, added = m.insert(n.right, key, value)
+	default:
+		n.value = value
+		return n, false
+	}
+	return rebalance(n), added
+}
+
+func (m *TreeMap[K, V]) remove(n *treeNode[K, V], key K) (*treeNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var removed bool
+	switch c := m.cmp(key, n.key); {
+	case c < 0:
+		n.left, removed = m.remove(n.left, key)
+	case c > 0:
+		n.right, removed = m.remove(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right, _ = m.remove(n.right, successor.key)
+		removed = true
+	}
+	return rebalance(n), removed
+}
+
+func minNode[K, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func nodeEntry[K, V any](n *treeNode[K, V]) (key K, value V, ok bool) {
+	if n == nil {
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func height[K, V any](n *treeNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func subtreeSize[K, V any](n *treeNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treeNode[K, V]) update() {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.size = 1 + subtreeSize(n.left) + subtreeSize(n.right)
+}
+
+func rotateRight[K, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update()
+	l.update()
+	return l
+}
+
+func rotateLeft[K, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update()
+	r.update()
+	return r
+}
+
+// rebalance восстанавливает АВЛ-инвариант в узле после изменения одного из поддеревьев
+func rebalance[K, V any](n *treeNode[K, V]) *treeNode[K, V] {
+	n.update()
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
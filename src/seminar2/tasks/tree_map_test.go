@@ -0,0 +1,164 @@
+package tasks
+
+import (
+	"iter"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeMap(t *testing.T) {
+	t.Parallel()
+
+	m := NewTreeMap[int, string]()
+	for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+		m.Put(k, strings.Repeat("x", k/10))
+	}
+	m.Put(30, "thirty")
+
+	assert.Equal(t, 7, m.Len())
+	assert.Equal(t, []int{10, 20, 30, 50, 70, 80, 90}, slices.Collect(m.Keys()))
+
+	v, ok := m.Get(30)
+	assert.True(t, ok)
+	assert.Equal(t, "thirty", v)
+	_, ok = m.Get(31)
+	assert.False(t, ok)
+
+	assert.True(t, m.Delete(50))
+	assert.False(t, m.Delete(50))
+	assert.Equal(t, 6, m.Len())
+	assert.Equal(t, []int{10, 20, 30, 70, 80, 90}, slices.Collect(m.Keys()))
+}
+
+func TestTreeMapMinMax(t *testing.T) {
+	t.Parallel()
+
+	m := NewTreeMap[string, int]()
+	_, _, ok := m.Min()
+	assert.False(t, ok)
+	_, _, ok = m.Max()
+	assert.False(t, ok)
+
+	m.Put("m", 1)
+	m.Put("c", 2)
+	m.Put("x", 3)
+
+	k, v, ok := m.Min()
+	assert.True(t, ok)
+	assert.Equal(t, "c", k)
+	assert.Equal(t, 2, v)
+
+	k, _, ok = m.Max()
+	assert.True(t, ok)
+	assert.Equal(t, "x", k)
+}
+
+func TestTreeMapFloorCeiling(t *testing.T) {
+	t.Parallel()
+
+	m := NewTreeMap[int, int]()
+	for _, k := range []int{10, 20, 30} {
+		m.Put(k, k*k)
+	}
+
+	tests := []struct {
+		name      string
+		key       int
+		floor     int
+		floorOK   bool
+		ceiling   int
+		ceilingOK bool
+	}{
+		{"exact", 20, 20, true, 20, true},
+		{"between", 25, 20, true, 30, true},
+		{"below all", 5, 0, false, 10, true},
+		{"above all", 35, 30, true, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			k, _, ok := m.Floor(tt.key)
+			assert.Equal(t, tt.floorOK, ok)
+			assert.Equal(t, tt.floor, k)
+
+			k, _, ok = m.Ceiling(tt.key)
+			assert.Equal(t, tt.ceilingOK, ok)
+			assert.Equal(t, tt.ceiling, k)
+		})
+	}
+}
+
+func TestTreeMapRange(t *testing.T) {
+	t.Parallel()
+
+	m := NewTreeMap[int, bool]()
+	for k := range 20 {
+		m.Put(k*5, true)
+	}
+
+	assert.Equal(t, []int{15, 20, 25, 30}, collectKeys(m.Range(12, 30)))
+	assert.Equal(t, []int{0}, collectKeys(m.Range(-10, 0)))
+	assert.Empty(t, collectKeys(m.Range(31, 34)))
+	assert.Empty(t, collectKeys(m.Range(30, 10)))
+
+	var first []int
+	for k := range m.Range(40, 90) {
+		first = append(first, k)
+		if len(first) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{40, 45}, first)
+}
+
+func TestTreeMapFunc(t *testing.T) {
+	t.Parallel()
+
+	m := NewTreeMapFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Put("Banana", 1)
+	m.Put("apple", 2)
+	m.Put("BANANA", 3)
+
+	assert.Equal(t, map[string]int{"apple": 2, "Banana": 3}, maps.Collect(m.All()))
+}
+
+func TestTreeMapBalanced(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	m := NewTreeMap[int, int]()
+	reference := make(map[int]int)
+	for i := range 5000 {
+		k := rng.IntN(1000)
+		if rng.IntN(3) == 0 {
+			_, ok := reference[k]
+			assert.Equal(t, ok, m.Delete(k))
+			delete(reference, k)
+		} else {
+			m.Put(k, i)
+			reference[k] = i
+		}
+	}
+
+	assert.Equal(t, len(reference), m.Len())
+	assert.Equal(t, reference, maps.Collect(m.All()))
+	assert.True(t, slices.IsSorted(slices.Collect(m.Keys())))
+	assert.LessOrEqual(t, height(m.root), 15)
+	assert.Equal(t, m.Len(), subtreeSize(m.root))
+}
+
+func collectKeys[K, V any](seq iter.Seq2[K, V]) []K {
+	var keys []K
+	for k := range seq {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,63 @@
+package tasks
+
+// Unique возвращает элементы xs без повторов, сохраняя порядок первых вхождений
+func Unique[T comparable](xs []T) []T {
+	return UniqueBy(xs, func(x T) T { return x })
+}
+
+// UniqueBy возвращает элементы xs без повторов ключа key, сохраняя первые вхождения
+func UniqueBy[T any, K comparable](xs []T, key func(T) K) []T {
+	return Filter(xs, firstByKey(key))
+}
+
+// Compact возвращает элементы xs без нулевых значений
+func Compact[T comparable](xs []T) []T {
+	return Filter(xs, isNonZero[T])
+}
+
+// UniqueInPlace удаляет повторы прямо в xs без выделения нового слайса и возвращает укороченный слайс.
+// Освободившийся хвост xs обнуляется
+func UniqueInPlace[T comparable](xs []T) []T {
+	return UniqueByInPlace(xs, func(x T) T { return x })
+}
+
+// UniqueByInPlace удаляет повторы ключа key прямо в xs и возвращает укороченный слайс
+func UniqueByInPlace[T any, K comparable](xs []T, key func(T) K) []T {
+	return keepInPlace(xs, firstByKey(key))
+}
+
+// CompactInPlace удаляет нулевые значения прямо в xs и возвращает укороченный слайс
+func CompactInPlace[T comparable](xs []T) []T {
+	return keepInPlace(xs, isNonZero[T])
+}
+
+// firstByKey возвращает предикат, истинный только для первого элемента с каждым ключом
+func firstByKey[T any, K comparable](key func(T) K) func(T) bool {
+	seen := make(map[K]struct{})
+	return func(x T) bool {
+		k := key(x)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	}
+}
+
+func isNonZero[T comparable](x T) bool {
+	var zero T
+	return x != zero
+}
+
+// keepInPlace сдвигает к началу xs элементы, для которых keep истинен, и обнуляет остаток
+func keepInPlace[T any](xs []T, keep func(T) bool) []T {
+	n := 0
+	for _, x := range xs {
+		if keep(x) {
+			xs[n] = x
+			n++
+		}
+	}
+	clear(xs[n:])
+	return xs[:n]
+}
@@ -0,0 +1,63 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"keeps first occurrences", []int{3, 1, 3, 2, 1}, []int{3, 1, 2}},
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"empty input", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Unique(tt.input))
+
+			inPlace := append([]int(nil), tt.input...)
+			assert.Equal(t, tt.expected, UniqueInPlace(inPlace))
+		})
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		email string
+		name  string
+	}
+	users := []user{{"a@x", "ann"}, {"A@X", "ann again"}, {"b@x", "bob"}}
+	byEmail := func(u user) string { return strings.ToLower(u.email) }
+
+	expected := []user{{"a@x", "ann"}, {"b@x", "bob"}}
+	assert.Equal(t, expected, UniqueBy(users, byEmail))
+	assert.Equal(t, expected, UniqueByInPlace(users, byEmail))
+	assert.Equal(t, user{}, users[2], "tail is cleared")
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	words := []string{"a", "", "b", "", "c"}
+	assert.Equal(t, []string{"a", "b", "c"}, Compact(words))
+	assert.Equal(t, []string{"a", "", "b", "", "c"}, words, "Compact does not modify input")
+
+	compacted := CompactInPlace(words)
+	assert.Equal(t, []string{"a", "b", "c"}, compacted)
+	assert.Equal(t, &words[0], &compacted[0], "in-place variant reuses memory")
+
+	assert.Nil(t, Compact([]int{0, 0}))
+	assert.Empty(t, CompactInPlace([]int{0, 0}))
+}
@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrLengthMismatch возвращается Zip в режиме ZipStrict, если длины слайсов различаются
+var ErrLengthMismatch = errors.New("slice lengths differ")
+
+// Pair — два значения, возможно разных типов
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// ZipPolicy определяет, как Zip поступает со слайсами разной длины
+type ZipPolicy int
+
+const (
+	// ZipShortest обрезает результат по более короткому слайсу
+	ZipShortest ZipPolicy = iota
+	// ZipLongest дополняет более короткий слайс нулевыми значениями
+	ZipLongest
+	// ZipStrict возвращает ErrLengthMismatch, если длины различаются
+	ZipStrict
+)
+
+// Zip объединяет элементы a и b с одинаковыми индексами в пары; длина результата зависит от policy
+func Zip[A, B any](a []A, b []B, policy ZipPolicy) ([]Pair[A, B], error) {
+	n := min(len(a), len(b))
+	switch policy {
+	case ZipShortest:
+	case ZipLongest:
+		n = max(len(a), len(b))
+	case ZipStrict:
+		if len(a) != len(b) {
+			return nil, fmt.Errorf("%w: %d and %d", ErrLengthMismatch, len(a), len(b))
+		}
+	default:
+		return nil, fmt.Errorf("unknown zip policy %d", policy)
+	}
+
+	result := make([]Pair[A, B], n)
+	for i := range result {
+		if i < len(a) {
+			result[i].First = a[i]
+		}
+		if i < len(b) {
+			result[i].Second = b[i]
+		}
+	}
+	return result, nil
+}
+
+// ZipWith применяет f к элементам a и b с одинаковыми индексами; длина результата — длина более короткого слайса
+func ZipWith[A, B, C any](a []A, b []B, f func(A, B) C) []C {
+	result := make([]C, min(len(a), len(b)))
+	for i := range result {
+		result[i] = f(a[i], b[i])
+	}
+	return result
+}
+
+// Unzip разделяет слайс пар на слайс первых и слайс вторых значений
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	first := make([]A, len(pairs))
+	second := make([]B, len(pairs))
+	for i, p := range pairs {
+		first[i], second[i] = p.First, p.Second
+	}
+	return first, second
+}
@@ -0,0 +1,75 @@
+package tasks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"ann", "bob", "eve"}
+	ages := []int{17, 25}
+
+	tests := []struct {
+		name     string
+		policy   ZipPolicy
+		expected []Pair[string, int]
+		err      error
+	}{
+		{"shortest", ZipShortest, []Pair[string, int]{{"ann", 17}, {"bob", 25}}, nil},
+		{"longest", ZipLongest, []Pair[string, int]{{"ann", 17}, {"bob", 25}, {"eve", 0}}, nil},
+		{"strict", ZipStrict, nil, ErrLengthMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := Zip(names, ages, tt.policy)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestZipEqualLengths(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []ZipPolicy{ZipShortest, ZipLongest, ZipStrict} {
+		result, err := Zip([]int{1, 2}, []bool{true, false}, policy)
+		assert.NoError(t, err)
+		assert.Equal(t, []Pair[int, bool]{{1, true}, {2, false}}, result)
+	}
+
+	_, err := Zip([]int{1}, []int{1}, ZipPolicy(42))
+	assert.Error(t, err)
+}
+
+func TestZipWith(t *testing.T) {
+	t.Parallel()
+
+	prices := []float64{10, 2.5, 4}
+	quantities := []int{3, 4}
+
+	totals := ZipWith(prices, quantities, func(p float64, q int) float64 { return p * float64(q) })
+	assert.Equal(t, []float64{30, 10}, totals)
+}
+
+func TestUnzip(t *testing.T) {
+	t.Parallel()
+
+	pairs, _ := Zip([]string{"x", "y"}, []int{1, 2}, ZipStrict)
+	first, second := Unzip(pairs)
+
+	assert.Equal(t, []string{"x", "y"}, first)
+	assert.Equal(t, []int{1, 2}, second)
+
+	first, second = Unzip[string, int](nil)
+	assert.Empty(t, first)
+	assert.Empty(t, second)
+}
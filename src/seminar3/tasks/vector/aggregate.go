@@ -0,0 +1,60 @@
+package vector
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Min returns the smallest element according to cmp
+// If several elements are minimal, the first one is returned
+func (v *Vector[T]) Min(cmp func(a, b T) int) (T, error) {
+	if v.size == 0 {
+		var zero T
+		return zero, ErrEmptyVector
+	}
+	return slices.MinFunc(v.elements(), cmp), nil
+}
+
+// Max returns the largest element according to cmp
+// If several elements are maximal, the first one is returned
+func (v *Vector[T]) Max(cmp func(a, b T) int) (T, error) {
+	if v.size == 0 {
+		var zero T
+		return zero, ErrEmptyVector
+	}
+	return slices.MaxFunc(v.elements(), cmp), nil
+}
+
+// MinMax returns both the smallest and the largest element in a single pass
+func (v *Vector[T]) MinMax(cmp func(a, b T) int) (T, T, error) {
+	if v.size == 0 {
+		var zero T
+		return zero, zero, ErrEmptyVector
+	}
+
+	minValue, maxValue := v.data[0], v.data[0]
+	for _, value := range v.data[1:v.size] {
+		if cmp(value, minValue) < 0 {
+			minValue = value
+		}
+		if cmp(value, maxValue) > 0 {
+			maxValue = value
+		}
+	}
+	return minValue, maxValue, nil
+}
+
+// MinOrdered returns the smallest element of a vector of ordered values
+func MinOrdered[T cmp.Ordered](v *Vector[T]) (T, error) {
+	return v.Min(cmp.Compare[T])
+}
+
+// MaxOrdered returns the largest element of a vector of ordered values
+func MaxOrdered[T cmp.Ordered](v *Vector[T]) (T, error) {
+	return v.Max(cmp.Compare[T])
+}
+
+// MinMaxOrdered returns the smallest and the largest element of a vector of ordered values
+func MinMaxOrdered[T cmp.Ordered](v *Vector[T]) (T, T, error) {
+	return v.MinMax(cmp.Compare[T])
+}
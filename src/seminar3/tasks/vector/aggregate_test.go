@@ -0,0 +1,66 @@
+package vector
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinMax(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	byAge := func(a, b Person) int { return cmp.Compare(a.Age, b.Age) }
+
+	v := New[Person](WithValues(
+		Person{"Alice", 30},
+		Person{"Bob", 25},
+		Person{"Carol", 40},
+		Person{"Dave", 25},
+	))
+
+	youngest, err := v.Min(byAge)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bob", youngest.Name)
+
+	oldest, err := v.Max(byAge)
+	assert.NoError(t, err)
+	assert.Equal(t, "Carol", oldest.Name)
+
+	youngest, oldest, err = v.MinMax(byAge)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bob", youngest.Name)
+	assert.Equal(t, "Carol", oldest.Name)
+}
+
+func TestMinMaxEmpty(t *testing.T) {
+	v := New[int]()
+
+	_, err := v.Min(cmp.Compare[int])
+	assert.ErrorIs(t, err, ErrEmptyVector)
+
+	_, err = v.Max(cmp.Compare[int])
+	assert.ErrorIs(t, err, ErrEmptyVector)
+
+	_, _, err = v.MinMax(cmp.Compare[int])
+	assert.ErrorIs(t, err, ErrEmptyVector)
+}
+
+func TestMinMaxOrdered(t *testing.T) {
+	v := New[float64](WithValues(2.5, -1, 7, 3))
+
+	minValue, err := MinOrdered(v)
+	assert.NoError(t, err)
+	assert.Equal(t, -1.0, minValue)
+
+	maxValue, err := MaxOrdered(v)
+	assert.NoError(t, err)
+	assert.Equal(t, 7.0, maxValue)
+
+	minValue, maxValue, err = MinMaxOrdered(New[float64](WithValues(4.0)))
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, minValue)
+	assert.Equal(t, 4.0, maxValue)
+}
@@ -0,0 +1,19 @@
+package vector
+
+// WithAllocator returns an option to obtain backing arrays from alloc instead of make,
+// for example from an arena or a memory-mapped region.
+// alloc must return a slice with room for at least n elements; the vector never frees it,
+// so releasing the memory is up to the allocator once the vector is no longer used
+func WithAllocator[T any](alloc func(n int) []T) Option[T] {
+	return func(v *Vector[T]) {
+		v.allocator = alloc
+	}
+}
+
+// allocate returns a backing array of exactly n elements
+func (v *Vector[T]) allocate(n int) []T {
+	if v.allocator == nil {
+		return make([]T, n)
+	}
+	return v.allocator(n)[:n:n]
+}
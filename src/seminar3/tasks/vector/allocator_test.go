@@ -0,0 +1,56 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// arena hands out consecutive chunks of one preallocated buffer
+type arena struct {
+	buf   []int
+	used  int
+	calls []int
+}
+
+func (a *arena) alloc(n int) []int {
+	a.calls = append(a.calls, n)
+	chunk := a.buf[a.used : a.used+n]
+	a.used += n
+	return chunk
+}
+
+func TestWithAllocator(t *testing.T) {
+	t.Run("Backing arrays come from the allocator", func(t *testing.T) {
+		a := &arena{buf: make([]int, 64)}
+		v := New[int](WithAllocator(a.alloc))
+		for i := range 5 {
+			assert.NoError(t, v.PushBack(i))
+		}
+
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, v.Data())
+		assert.Equal(t, []int{1, 2, 4, 8}, a.calls)
+		assert.Equal(t, []int{0, 1, 2, 3, 4}, a.buf[7:12], "elements live in the arena")
+		assert.Equal(t, 8, v.Capacity())
+	})
+
+	t.Run("Extra room is not exposed", func(t *testing.T) {
+		v := New[int](WithAllocator(func(n int) []int {
+			return make([]int, n+10)
+		}))
+		v.Reserve(4)
+		assert.Equal(t, 4, v.Capacity())
+		assert.Len(t, v.data, 4)
+	})
+
+	t.Run("Clone uses the same allocator", func(t *testing.T) {
+		a := &arena{buf: make([]int, 64)}
+		v := New[int](WithAllocator(a.alloc))
+		v.PushBack(1)
+		calls := len(a.calls)
+
+		clone := v.Clone()
+		assert.Equal(t, []int{1}, clone.Data())
+		assert.Len(t, a.calls, calls+1)
+	})
+}
@@ -0,0 +1,33 @@
+package vector
+
+import "iter"
+
+// Chunk splits the vector into independent vectors of at most size elements
+// The last chunk may be shorter. A non-positive size yields no chunks
+func (v *Vector[T]) Chunk(size int) []*Vector[T] {
+	if size <= 0 {
+		return nil
+	}
+
+	chunks := make([]*Vector[T], 0, (v.size+size-1)/size)
+	for from := 0; from < v.size; from += size {
+		to := min(from+size, v.size)
+		chunks = append(chunks, New[T](FromSlice(v.data[from:to])))
+	}
+	return chunks
+}
+
+// Windows returns an iterator over all sliding windows of exactly size consecutive elements
+// The yielded slices share memory with the vector and must not be retained or appended to
+func (v *Vector[T]) Windows(size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+		for from := 0; from+size <= v.size; from++ {
+			if !yield(v.data[from : from+size : from+size]) {
+				return
+			}
+		}
+	}
+}
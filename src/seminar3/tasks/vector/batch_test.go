@@ -0,0 +1,60 @@
+package vector
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5))
+
+	chunks := v.Chunk(2)
+	assert.Len(t, chunks, 3)
+	assert.Equal(t, []int{1, 2}, chunks[0].Data())
+	assert.Equal(t, []int{3, 4}, chunks[1].Data())
+	assert.Equal(t, []int{5}, chunks[2].Data())
+
+	chunks[0].Data()[0] = 100
+	val, _ := v.At(0)
+	assert.Equal(t, 1, val, "chunks must not share memory with the source")
+
+	assert.Len(t, v.Chunk(10), 1)
+	assert.Empty(t, v.Chunk(0))
+	assert.Empty(t, New[int]().Chunk(3))
+}
+
+func TestWindows(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4))
+
+	var windows [][]int
+	for w := range v.Windows(2) {
+		windows = append(windows, slices.Clone(w))
+	}
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}, {3, 4}}, windows)
+
+	assert.Len(t, slices.Collect(v.Windows(4)), 1)
+	assert.Empty(t, slices.Collect(v.Windows(5)))
+	assert.Empty(t, slices.Collect(v.Windows(0)))
+}
+
+func TestWindowsEarlyExit(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5))
+
+	count := 0
+	for range v.Windows(3) {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestWindowsCannotOverwriteVector(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+
+	for w := range v.Windows(1) {
+		_ = append(w, 100)
+	}
+	assert.Equal(t, []int{1, 2, 3}, v.Data())
+}
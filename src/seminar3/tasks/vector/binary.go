@@ -0,0 +1,26 @@
+package vector
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// MarshalBinary encodes the elements of the vector with encoding/gob
+// Since Vector implements encoding.BinaryMarshaler, it can be sent directly with gob and net/rpc
+func (v *Vector[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v.elements()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes elements produced by MarshalBinary into the vector
+// The existing capacity is reused when it is large enough to hold the decoded values
+func (v *Vector[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+	return v.Assign(values...)
+}
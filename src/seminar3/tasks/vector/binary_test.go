@@ -0,0 +1,61 @@
+package vector
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = (*Vector[int])(nil)
+	_ encoding.BinaryUnmarshaler = (*Vector[int])(nil)
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c"))
+
+	data, err := v.MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := New[string]()
+	err = decoded.UnmarshalBinary(data)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, decoded.Data())
+}
+
+func TestBinaryEmptyVector(t *testing.T) {
+	data, err := New[int]().MarshalBinary()
+	assert.NoError(t, err)
+
+	decoded := New[int](WithValues(1, 2))
+	err = decoded.UnmarshalBinary(data)
+	assert.NoError(t, err)
+	assert.True(t, decoded.Empty())
+}
+
+func TestBinaryInvalidData(t *testing.T) {
+	err := New[int]().UnmarshalBinary([]byte("garbage"))
+	assert.Error(t, err)
+}
+
+func TestGob(t *testing.T) {
+	type Point struct {
+		X, Y int
+	}
+	type Message struct {
+		Name   string
+		Points *Vector[Point]
+	}
+
+	var buf bytes.Buffer
+	msg := Message{Name: "path", Points: New[Point](WithValues(Point{1, 2}, Point{3, 4}))}
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(msg))
+
+	var decoded Message
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, "path", decoded.Name)
+	assert.Equal(t, []Point{{1, 2}, {3, 4}}, decoded.Points.Data())
+}
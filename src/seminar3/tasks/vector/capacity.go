@@ -0,0 +1,65 @@
+package vector
+
+import "math"
+
+// WithGrowthFactor returns an option to multiply the capacity by factor when the vector grows.
+// Factors not greater than 1 are ignored and the default doubling is kept
+func WithGrowthFactor[T any](factor float64) Option[T] {
+	return func(v *Vector[T]) {
+		if factor <= 1 {
+			return
+		}
+		v.growthFunc = func(capacity int) int {
+			return int(math.Ceil(float64(capacity) * factor))
+		}
+	}
+}
+
+// WithGrowthFunc returns an option to compute the new capacity from the current one.
+// The vector always grows by at least one element regardless of the returned value
+func WithGrowthFunc[T any](growth func(capacity int) int) Option[T] {
+	return func(v *Vector[T]) {
+		v.growthFunc = growth
+	}
+}
+
+// WithMaxCapacity returns an option that bounds the vector to n elements:
+// operations that would grow it further fail with ErrCapacityExceeded.
+// Initial values beyond n are discarded. A non-positive n means no limit
+func WithMaxCapacity[T any](n int) Option[T] {
+	return func(v *Vector[T]) {
+		v.maxCapacity = max(n, 0)
+	}
+}
+
+// WithShrinkPolicy returns an option that makes the vector release memory automatically:
+// whenever size drops below fraction*capacity the capacity is reduced to twice the size.
+// A fraction outside (0, 1) disables automatic shrinking
+func WithShrinkPolicy[T any](fraction float64) Option[T] {
+	return func(v *Vector[T]) {
+		if fraction <= 0 || fraction >= 1 {
+			fraction = 0
+		}
+		v.shrinkFraction = fraction
+	}
+}
+
+// ShrinkToFit reduces the capacity of the vector to its size
+func (v *Vector[T]) ShrinkToFit() {
+	if v.capacity > v.size {
+		v.reserve(v.size)
+	}
+}
+
+// shrinkIfNeeded applies the shrink policy after elements were removed
+func (v *Vector[T]) shrinkIfNeeded() {
+	if v.shrinkFraction == 0 || float64(v.size) >= v.shrinkFraction*float64(v.capacity) {
+		return
+	}
+
+	newCapacity := v.size * 2
+	if newCapacity >= v.capacity {
+		newCapacity = v.size
+	}
+	v.reserve(newCapacity)
+}
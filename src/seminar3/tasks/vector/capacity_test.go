@@ -0,0 +1,199 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrowthFactor(t *testing.T) {
+	v := New[int](WithGrowthFactor[int](1.5))
+
+	var capacities []int
+	for i := 0; i < 10; i++ {
+		v.PushBack(i)
+		capacities = append(capacities, v.Capacity())
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 5, 5, 8, 8, 8, 12, 12}, capacities)
+}
+
+func TestGrowthFactorInvalid(t *testing.T) {
+	v := New[int](WithGrowthFactor[int](0.5))
+	v.PushBack(1)
+	v.PushBack(2)
+	v.PushBack(3)
+	assert.Equal(t, 4, v.Capacity())
+}
+
+func TestGrowthFunc(t *testing.T) {
+	t.Run("Chunked growth", func(t *testing.T) {
+		v := New[int](WithGrowthFunc[int](func(capacity int) int { return capacity + 4 }))
+		for i := 0; i < 9; i++ {
+			v.PushBack(i)
+		}
+		assert.Equal(t, 12, v.Capacity())
+	})
+
+	t.Run("Always grows", func(t *testing.T) {
+		v := New[int](WithGrowthFunc[int](func(capacity int) int { return capacity }))
+		v.PushBack(1)
+		v.PushBack(2)
+		assert.Equal(t, 2, v.Size())
+		assert.Equal(t, 2, v.Capacity())
+	})
+
+	t.Run("Bulk insert jumps to required size", func(t *testing.T) {
+		v := New[int](WithGrowthFunc[int](func(capacity int) int { return capacity + 1 }))
+		assert.NoError(t, v.InsertSlice(0, []int{1, 2, 3, 4, 5}))
+		assert.Equal(t, 5, v.Capacity())
+	})
+}
+
+func TestMaxCapacity(t *testing.T) {
+	t.Run("Push back", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](3))
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, v.PushBack(i))
+		}
+		assert.Equal(t, 3, v.Capacity(), "growth is capped at the maximum")
+
+		err := v.PushBack(3)
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+		assert.Equal(t, []int{0, 1, 2}, v.Data())
+	})
+
+	t.Run("Insert", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](2), WithValues(1, 2))
+		assert.ErrorIs(t, v.Insert(0, 0), ErrCapacityExceeded)
+		assert.ErrorIs(t, v.InsertSlice(0, []int{0}), ErrCapacityExceeded)
+		assert.Equal(t, []int{1, 2}, v.Data())
+
+		assert.NoError(t, v.PopBack())
+		assert.NoError(t, v.Insert(0, 0))
+		assert.Equal(t, []int{0, 1}, v.Data())
+	})
+
+	t.Run("Resize and assign", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](4))
+		assert.ErrorIs(t, v.Resize(5, 0), ErrCapacityExceeded)
+		assert.NoError(t, v.Resize(4, 1))
+		assert.ErrorIs(t, v.Assign(1, 2, 3, 4, 5), ErrCapacityExceeded)
+		assert.Equal(t, []int{1, 1, 1, 1}, v.Data())
+	})
+
+	t.Run("Reserve is capped", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](4))
+		v.Reserve(100)
+		assert.Equal(t, 4, v.Capacity())
+	})
+
+	t.Run("Initial values are cut", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](2), WithValues(1, 2, 3))
+		assert.Equal(t, []int{1, 2}, v.Data())
+		assert.Equal(t, 2, v.Capacity())
+	})
+
+	t.Run("Sequence stops when full", func(t *testing.T) {
+		v := FromSeq(New[int](WithValues(1, 2, 3, 4)).Values(), WithMaxCapacity[int](2))
+		assert.Equal(t, []int{1, 2}, v.Data())
+	})
+
+	t.Run("Non-positive means unlimited", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](0))
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, v.PushBack(i))
+		}
+	})
+}
+
+func TestShrinkToFit(t *testing.T) {
+	t.Run("Releases spare capacity", func(t *testing.T) {
+		v := New[int](WithCapacity[int](100))
+		v.Assign(1, 2, 3)
+
+		v.ShrinkToFit()
+		assert.Equal(t, 3, v.Capacity())
+		assert.Equal(t, []int{1, 2, 3}, v.Data())
+	})
+
+	t.Run("Empty vector", func(t *testing.T) {
+		v := New[int](WithCapacity[int](10))
+		v.ShrinkToFit()
+		assert.Equal(t, 0, v.Capacity())
+
+		v.PushBack(1)
+		assert.Equal(t, 1, v.Capacity())
+	})
+}
+
+func TestShrinkPolicy(t *testing.T) {
+	t.Run("Shrinks below threshold", func(t *testing.T) {
+		v := New[int](WithShrinkPolicy[int](0.25), WithCapacity[int](16))
+		for i := 0; i < 16; i++ {
+			v.PushBack(i)
+		}
+
+		for v.Size() > 4 {
+			assert.NoError(t, v.PopBack())
+		}
+		assert.Equal(t, 16, v.Capacity(), "size equal to the threshold keeps capacity")
+
+		assert.NoError(t, v.PopBack())
+		assert.Equal(t, 6, v.Capacity())
+		assert.Equal(t, []int{0, 1, 2}, v.Data())
+	})
+
+	t.Run("Clear releases memory", func(t *testing.T) {
+		v := New[int](WithShrinkPolicy[int](0.5), WithValues(1, 2, 3, 4))
+		v.Clear()
+		assert.Equal(t, 0, v.Capacity())
+	})
+
+	t.Run("Erase range", func(t *testing.T) {
+		v := New[int](WithShrinkPolicy[int](0.5), WithFill(10, 7))
+		assert.NoError(t, v.EraseRange(0, 8))
+		assert.Equal(t, 4, v.Capacity())
+		assert.Equal(t, []int{7, 7}, v.Data())
+	})
+
+	t.Run("Disabled by default", func(t *testing.T) {
+		v := New[int](WithFill(10, 1))
+		v.Resize(1, 0)
+		assert.Equal(t, 10, v.Capacity())
+	})
+
+	t.Run("Invalid fraction disables policy", func(t *testing.T) {
+		v := New[int](WithShrinkPolicy[int](1.5), WithFill(10, 1))
+		v.Clear()
+		assert.Equal(t, 10, v.Capacity())
+	})
+}
+
+func BenchmarkGrowthStrategy(b *testing.B) {
+	const n = 10000
+
+	strategies := []struct {
+		name   string
+		option Option[int]
+	}{
+		{"Doubling", WithGrowthFunc[int](nil)},
+		{"Factor 1.5", WithGrowthFactor[int](1.5)},
+		{"Chunks of 64", WithGrowthFunc[int](func(capacity int) int { return capacity + 64 })},
+	}
+
+	for _, s := range strategies {
+		b.Run(s.name, func(b *testing.B) {
+			b.ReportAllocs()
+			var capacity int
+			for i := 0; i < b.N; i++ {
+				v := New[int](s.option)
+				for j := 0; j < n; j++ {
+					v.PushBack(j)
+				}
+				capacity = v.Capacity()
+			}
+			b.ReportMetric(float64(capacity)/n, "cap/size")
+		})
+	}
+}
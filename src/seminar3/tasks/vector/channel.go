@@ -0,0 +1,57 @@
+package vector
+
+import "context"
+
+// FromChannel creates a new vector by draining ch until it is closed
+// Options are applied before the values are appended. With WithMaxCapacity receiving stops
+// at the first value that does not fit: that value is lost, the rest stay in ch,
+// and the values received so far are returned together with ErrCapacityExceeded
+func FromChannel[T any](ch <-chan T, options ...Option[T]) (*Vector[T], error) {
+	v := New[T](options...)
+	for value := range ch {
+		if err := v.PushBack(value); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+// FromChannelContext is like FromChannel but stops early when ctx is done,
+// returning the values received so far together with the context error
+func FromChannelContext[T any](ctx context.Context, ch <-chan T, options ...Option[T]) (*Vector[T], error) {
+	v := New[T](options...)
+	for {
+		select {
+		case <-ctx.Done():
+			return v, ctx.Err()
+		case value, ok := <-ch:
+			if !ok {
+				return v, nil
+			}
+			if err := v.PushBack(value); err != nil {
+				return v, err
+			}
+		}
+	}
+}
+
+// ToChannel streams the elements into an unbuffered channel that is closed after the last one
+// or when ctx is done. The elements are read from a snapshot, so the vector
+// may be modified while the channel is being consumed
+func (v *Vector[T]) ToChannel(ctx context.Context) <-chan T {
+	ch := make(chan T)
+	snapshot := v.Snapshot()
+
+	go func() {
+		defer close(ch)
+		for value := range snapshot.Values() {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- value:
+			}
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,127 @@
+package vector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromChannel(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	v, err := FromChannel(ch, WithCapacity[int](8))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, v.Data())
+	assert.Equal(t, 8, v.Capacity())
+}
+
+func TestFromChannelMaxCapacity(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	v, err := FromChannel(ch, WithMaxCapacity[int](2))
+	assert.ErrorIs(t, err, ErrCapacityExceeded)
+	assert.Equal(t, []int{1, 2}, v.Data())
+	assert.Len(t, ch, 2, "receiving must stop after the value that did not fit")
+}
+
+func TestFromChannelContext(t *testing.T) {
+	t.Run("Closed channel", func(t *testing.T) {
+		ch := make(chan string, 2)
+		ch <- "a"
+		ch <- "b"
+		close(ch)
+
+		v, err := FromChannelContext(context.Background(), ch)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, v.Data())
+	})
+
+	t.Run("Cancelled context", func(t *testing.T) {
+		ch := make(chan int)
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			ch <- 1
+			cancel()
+		}()
+
+		v, err := FromChannelContext(ctx, ch)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []int{1}, v.Data())
+	})
+
+	t.Run("Max capacity", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+
+		v, err := FromChannelContext(context.Background(), ch, WithMaxCapacity[int](1))
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+		assert.Equal(t, []int{1}, v.Data())
+		assert.Len(t, ch, 1)
+	})
+}
+
+func TestToChannel(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+
+	var received []int
+	for value := range v.ToChannel(context.Background()) {
+		received = append(received, value)
+	}
+	assert.Equal(t, []int{1, 2, 3}, received)
+}
+
+func TestToChannelCancel(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := v.ToChannel(ctx)
+
+	assert.Equal(t, 1, <-ch)
+	cancel()
+
+	count := 0
+	for range ch {
+		count++
+	}
+	assert.LessOrEqual(t, count, 1, "at most one value may already be in flight")
+}
+
+func TestToChannelSurvivesMutation(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+	ch := v.ToChannel(context.Background())
+
+	v.Clear()
+	v.PushBack(100)
+
+	received, err := FromChannel(ch)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, received.Data())
+}
+
+func TestChannelPipeline(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4))
+	ctx := context.Background()
+
+	doubled := make(chan int)
+	go func() {
+		defer close(doubled)
+		for value := range v.ToChannel(ctx) {
+			doubled <- value * 2
+		}
+	}()
+
+	received, err := FromChannel(doubled)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 6, 8}, received.Data())
+}
@@ -0,0 +1,24 @@
+package vector
+
+// Clone returns an independent copy of the vector with the same size and capacity
+func (v *Vector[T]) Clone() *Vector[T] {
+	return v.CloneFunc(func(value T) T { return value })
+}
+
+// CloneFunc returns a copy of the vector where every element is produced by copyElem
+// Use it for element types holding pointers, slices or maps that must be deep-copied
+func (v *Vector[T]) CloneFunc(copyElem func(T) T) *Vector[T] {
+	clone := *v
+	clone.data = nil
+	clone.size = 0
+	clone.shared = false
+	clone.observers = nil
+	clone.reserve(v.capacity)
+	clone.reallocations, clone.elementsCopied = 0, 0
+
+	for i, value := range v.elements() {
+		clone.data[i] = copyElem(value)
+	}
+	clone.size = v.size
+	return &clone
+}
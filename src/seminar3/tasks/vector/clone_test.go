@@ -0,0 +1,47 @@
+package vector
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClone(t *testing.T) {
+	v := New[int](WithCapacity[int](8))
+	v.Assign(1, 2, 3)
+
+	clone := v.Clone()
+	assert.Equal(t, v.Data(), clone.Data())
+	assert.Equal(t, v.Capacity(), clone.Capacity())
+
+	clone.Data()[0] = 100
+	clone.PushBack(4)
+
+	val, _ := v.At(0)
+	assert.Equal(t, 1, val)
+	assert.Equal(t, 3, v.Size())
+}
+
+func TestCloneEmpty(t *testing.T) {
+	clone := New[string]().Clone()
+	assert.True(t, clone.Empty())
+
+	clone.PushBack("a")
+	assert.Equal(t, 1, clone.Size())
+}
+
+func TestCloneFunc(t *testing.T) {
+	v := New[[]int](WithValues([]int{1, 2}, []int{3}))
+
+	shallow := v.Clone()
+	deep := v.CloneFunc(slices.Clone[[]int])
+
+	v.Data()[0][0] = 100
+
+	shallowFirst, _ := shallow.At(0)
+	assert.Equal(t, 100, shallowFirst[0], "Clone shares nested slices")
+
+	deepFirst, _ := deep.At(0)
+	assert.Equal(t, []int{1, 2}, deepFirst)
+}
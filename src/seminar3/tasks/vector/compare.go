@@ -0,0 +1,27 @@
+package vector
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Equal reports whether both vectors have the same size and eq holds for every pair of elements
+func (v *Vector[T]) Equal(other *Vector[T], eq func(a, b T) bool) bool {
+	return slices.EqualFunc(v.elements(), other.elements(), eq)
+}
+
+// Compare compares the vectors lexicographically using cmp
+// The result is 0 if v == other, -1 if v < other, and +1 if v > other
+func (v *Vector[T]) Compare(other *Vector[T], cmp func(a, b T) int) int {
+	return slices.CompareFunc(v.elements(), other.elements(), cmp)
+}
+
+// EqualOrdered reports whether two vectors of comparable elements are equal
+func EqualOrdered[T comparable](a, b *Vector[T]) bool {
+	return slices.Equal(a.elements(), b.elements())
+}
+
+// CompareOrdered compares two vectors of ordered elements lexicographically
+func CompareOrdered[T cmp.Ordered](a, b *Vector[T]) int {
+	return slices.Compare(a.elements(), b.elements())
+}
@@ -0,0 +1,50 @@
+package vector
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	a := New[string](WithValues("a", "B"))
+	b := New[string](WithValues("A", "b"))
+
+	assert.True(t, a.Equal(b, strings.EqualFold))
+	assert.False(t, a.Equal(b, func(x, y string) bool { return x == y }))
+
+	shorter := New[string](WithValues("a"))
+	assert.False(t, a.Equal(shorter, strings.EqualFold))
+
+	assert.True(t, New[int]().Equal(New[int](WithCapacity[int](5)), func(x, y int) bool { return x == y }))
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected int
+	}{
+		{"equal", []int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{"less by element", []int{1, 2, 3}, []int{1, 3}, -1},
+		{"greater by element", []int{2}, []int{1, 9, 9}, 1},
+		{"prefix is less", []int{1, 2}, []int{1, 2, 3}, -1},
+		{"both empty", []int{}, []int{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New[int](FromSlice(tt.a))
+			b := New[int](FromSlice(tt.b))
+			assert.Equal(t, tt.expected, a.Compare(b, cmp.Compare[int]))
+			assert.Equal(t, tt.expected, CompareOrdered(a, b))
+		})
+	}
+}
+
+func TestEqualOrdered(t *testing.T) {
+	assert.True(t, EqualOrdered(New[int](WithValues(1, 2)), New[int](WithValues(1, 2))))
+	assert.False(t, EqualOrdered(New[int](WithValues(1, 2)), New[int](WithValues(2, 1))))
+}
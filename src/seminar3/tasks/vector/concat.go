@@ -0,0 +1,30 @@
+package vector
+
+// Append adds all elements of other to the end of the vector with at most one reallocation
+func (v *Vector[T]) Append(other *Vector[T]) error {
+	return v.InsertSlice(v.size, other.elements())
+}
+
+// Concat creates a new vector holding the elements of all vectors in order, skipping nil vectors
+// The result is allocated once with the exact total capacity
+func Concat[T any](vectors ...*Vector[T]) *Vector[T] {
+	total := 0
+	for _, v := range vectors {
+		if v != nil {
+			total += v.Size()
+		}
+	}
+
+	result := New[T](WithCapacity[T](total))
+	for _, v := range vectors {
+		if v != nil {
+			_ = result.Append(v)
+		}
+	}
+	return result
+}
+
+// Flatten concatenates the nested vectors of v into a single vector, skipping nil entries
+func Flatten[T any](v *Vector[*Vector[T]]) *Vector[T] {
+	return Concat(v.elements()...)
+}
@@ -0,0 +1,53 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppend(t *testing.T) {
+	v := New[int](WithValues(1, 2))
+	other := New[int](WithValues(3, 4, 5))
+
+	v.Append(other)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+	assert.Equal(t, []int{3, 4, 5}, other.Data())
+
+	v.Append(New[int]())
+	assert.Equal(t, 5, v.Size())
+}
+
+func TestAppendSelf(t *testing.T) {
+	v := New[int](WithValues(1, 2))
+	v.Append(v)
+	assert.Equal(t, []int{1, 2, 1, 2}, v.Data())
+}
+
+func TestConcat(t *testing.T) {
+	result := Concat(
+		New[string](WithValues("a")),
+		New[string](),
+		New[string](WithValues("b", "c")),
+	)
+	assert.Equal(t, []string{"a", "b", "c"}, result.Data())
+	assert.Equal(t, 3, result.Capacity())
+
+	assert.True(t, Concat[int]().Empty())
+
+	withNil := Concat(New[int](WithValues(1)), nil, New[int](WithValues(2)))
+	assert.Equal(t, []int{1, 2}, withNil.Data())
+	assert.True(t, Concat[int](nil, nil).Empty())
+}
+
+func TestFlatten(t *testing.T) {
+	nested := New[*Vector[int]](WithValues(
+		New[int](WithValues(1, 2)),
+		nil,
+		New[int](WithValues(3)),
+	))
+
+	flat := Flatten(nested)
+	assert.Equal(t, []int{1, 2, 3}, flat.Data())
+	assert.Equal(t, 3, flat.Capacity())
+}
@@ -0,0 +1,144 @@
+package vector
+
+import "sync"
+
+// ConcurrentVector is a Vector guarded by a sync.RWMutex and safe for concurrent use
+type ConcurrentVector[T any] struct {
+	mu  sync.RWMutex
+	vec *Vector[T]
+}
+
+// NewConcurrent creates a new thread-safe vector with the given options
+func NewConcurrent[T any](options ...Option[T]) *ConcurrentVector[T] {
+	return &ConcurrentVector[T]{vec: New[T](options...)}
+}
+
+// Size returns the number of elements in the vector
+func (c *ConcurrentVector[T]) Size() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.Size()
+}
+
+// Capacity returns the capacity of the vector
+func (c *ConcurrentVector[T]) Capacity() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.Capacity()
+}
+
+// Empty returns true if the vector is empty
+func (c *ConcurrentVector[T]) Empty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.Empty()
+}
+
+// At returns the element at the specified index with bounds checking
+func (c *ConcurrentVector[T]) At(index int) (T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.At(index)
+}
+
+// Front returns the first element
+func (c *ConcurrentVector[T]) Front() (T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.Front()
+}
+
+// Back returns the last element
+func (c *ConcurrentVector[T]) Back() (T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.Back()
+}
+
+// Data returns a copy of the elements, since the underlying slice cannot be shared safely
+func (c *ConcurrentVector[T]) Data() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	data := make([]T, c.vec.Size())
+	copy(data, c.vec.elements())
+	return data
+}
+
+// PushBack adds an element to the end of the vector
+func (c *ConcurrentVector[T]) PushBack(value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vec.PushBack(value)
+}
+
+// PopBack removes the last element from the vector
+func (c *ConcurrentVector[T]) PopBack() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vec.PopBack()
+}
+
+// Insert inserts an element at the specified position
+func (c *ConcurrentVector[T]) Insert(index int, value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vec.Insert(index, value)
+}
+
+// Erase removes the element at the specified position
+func (c *ConcurrentVector[T]) Erase(index int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vec.Erase(index)
+}
+
+// Clear removes all elements from the vector
+func (c *ConcurrentVector[T]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vec.Clear()
+}
+
+// Reserve increases the capacity of the vector
+func (c *ConcurrentVector[T]) Reserve(newCapacity int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vec.Reserve(newCapacity)
+}
+
+// Resize changes the size of the vector
+func (c *ConcurrentVector[T]) Resize(newSize int, value T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vec.Resize(newSize, value)
+}
+
+// Assign replaces the contents of the vector with new values
+func (c *ConcurrentVector[T]) Assign(values ...T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vec.Assign(values...)
+}
+
+// String returns a string representation of the vector as Vector[...]
+func (c *ConcurrentVector[T]) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.vec.String()
+}
+
+// Do runs fn on the live elements while holding the write lock,
+// so several reads and writes can be performed as one atomic step
+func (c *ConcurrentVector[T]) Do(fn func(data []T)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.vec.Data())
+}
+
+// Update runs fn with exclusive access to the wrapped vector,
+// allowing atomic sequences of structural changes
+func (c *ConcurrentVector[T]) Update(fn func(v *Vector[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(c.vec)
+}
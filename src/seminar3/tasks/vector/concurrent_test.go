@@ -0,0 +1,90 @@
+package vector
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentVectorBasic(t *testing.T) {
+	c := NewConcurrent[int](WithValues(1, 2, 3))
+
+	c.PushBack(4)
+	assert.Equal(t, 4, c.Size())
+
+	val, err := c.At(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, val)
+
+	assert.NoError(t, c.PopBack())
+	assert.NoError(t, c.Insert(0, 0))
+	assert.NoError(t, c.Erase(1))
+	assert.Equal(t, []int{0, 2, 3}, c.Data())
+	assert.Equal(t, "Vector[0 2 3]", c.String())
+
+	c.Clear()
+	assert.True(t, c.Empty())
+}
+
+func TestConcurrentVectorDataIsCopy(t *testing.T) {
+	c := NewConcurrent[int](WithValues(1, 2, 3))
+
+	data := c.Data()
+	data[0] = 100
+
+	val, _ := c.At(0)
+	assert.Equal(t, 1, val)
+}
+
+func TestConcurrentVectorParallelPushBack(t *testing.T) {
+	c := NewConcurrent[int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.PushBack(i)
+				_ = c.Size()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 8000, c.Size())
+}
+
+func TestConcurrentVectorDo(t *testing.T) {
+	c := NewConcurrent[int](WithSize(1, 0))
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				c.Do(func(data []int) {
+					data[0]++
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	val, _ := c.At(0)
+	assert.Equal(t, 800, val)
+}
+
+func TestConcurrentVectorUpdate(t *testing.T) {
+	c := NewConcurrent[int](WithValues(1, 2, 3))
+
+	c.Update(func(v *Vector[int]) {
+		back, _ := v.Back()
+		_ = v.PopBack()
+		_ = v.Insert(0, back)
+	})
+
+	assert.Equal(t, []int{3, 1, 2}, c.Data())
+}
@@ -0,0 +1,47 @@
+package vector
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// WriteCSV writes every element as one CSV record produced by encode
+func (v *Vector[T]) WriteCSV(w io.Writer, encode func(T) []string) error {
+	writer := csv.NewWriter(w)
+	for _, value := range v.elements() {
+		if err := writer.Write(encode(value)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ReadCSV creates a new vector from CSV records, converting each record with decode
+// The returned error mentions the line of the first record that failed to decode
+func ReadCSV[T any](r io.Reader, decode func(record []string) (T, error), options ...Option[T]) (*Vector[T], error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	v := New[T](options...)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return v, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := decode(record)
+		if err != nil {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("decode record on line %d: %w", line, err)
+		}
+		if err := v.PushBack(value); err != nil {
+			return nil, err
+		}
+	}
+}
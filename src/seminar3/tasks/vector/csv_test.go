@@ -0,0 +1,75 @@
+package vector
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type csvPerson struct {
+	Name string
+	Age  int
+}
+
+func encodePerson(p csvPerson) []string {
+	return []string{p.Name, strconv.Itoa(p.Age)}
+}
+
+func decodePerson(record []string) (csvPerson, error) {
+	if len(record) != 2 {
+		return csvPerson{}, errors.New("expected 2 fields")
+	}
+	age, err := strconv.Atoi(record[1])
+	if err != nil {
+		return csvPerson{}, err
+	}
+	return csvPerson{Name: record[0], Age: age}, nil
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	v := New[csvPerson](WithValues(
+		csvPerson{"Alice", 30},
+		csvPerson{"Bob, Jr.", 25},
+	))
+
+	var sb strings.Builder
+	err := v.WriteCSV(&sb, encodePerson)
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice,30\n\"Bob, Jr.\",25\n", sb.String())
+
+	decoded, err := ReadCSV(strings.NewReader(sb.String()), decodePerson)
+	assert.NoError(t, err)
+	assert.Equal(t, v.Data(), decoded.Data())
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	t.Run("Decode error", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("Alice,30\nBob,old\n"), decodePerson)
+		assert.ErrorIs(t, err, strconv.ErrSyntax)
+		assert.Contains(t, err.Error(), "line 2")
+	})
+
+	t.Run("Wrong field count", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("Alice\n"), decodePerson)
+		assert.Error(t, err)
+	})
+
+	t.Run("Malformed CSV", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("\"Alice,30\n"), decodePerson)
+		assert.Error(t, err)
+	})
+
+	t.Run("Capacity exceeded", func(t *testing.T) {
+		_, err := ReadCSV(strings.NewReader("A,1\nB,2\n"), decodePerson, WithMaxCapacity[csvPerson](1))
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+	})
+}
+
+func TestReadCSVEmpty(t *testing.T) {
+	v, err := ReadCSV(strings.NewReader(""), decodePerson)
+	assert.NoError(t, err)
+	assert.True(t, v.Empty())
+}
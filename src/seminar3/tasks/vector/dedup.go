@@ -0,0 +1,33 @@
+package vector
+
+import "slices"
+
+// Unique removes all repeated elements in place, keeping the first occurrence of each,
+// and returns the number of removed elements. It compares every pair, so it takes O(n²);
+// prefer UniqueComparable for comparable element types
+func (v *Vector[T]) Unique(eq func(a, b T) bool) int {
+	return v.compact(func(value T, kept []T) bool {
+		return !slices.ContainsFunc(kept, func(k T) bool { return eq(k, value) })
+	})
+}
+
+// UniqueComparable removes all repeated elements in O(n) using a set of seen values,
+// keeping the first occurrence of each, and returns the number of removed elements
+func UniqueComparable[T comparable](v *Vector[T]) int {
+	seen := make(map[T]struct{}, v.Size())
+	return v.RemoveIf(func(value T) bool {
+		if _, ok := seen[value]; ok {
+			return true
+		}
+		seen[value] = struct{}{}
+		return false
+	})
+}
+
+// DedupAdjacent collapses runs of consecutive equal elements into one
+// and returns the number of removed elements. On sorted data this removes all duplicates
+func (v *Vector[T]) DedupAdjacent(eq func(a, b T) bool) int {
+	return v.compact(func(value T, kept []T) bool {
+		return len(kept) == 0 || !eq(kept[len(kept)-1], value)
+	})
+}
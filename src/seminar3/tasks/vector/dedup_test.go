@@ -0,0 +1,55 @@
+package vector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	v := New[string](WithValues("Go", "rust", "go", "C", "RUST", "c"))
+
+	removed := v.Unique(strings.EqualFold)
+	assert.Equal(t, 3, removed)
+	assert.Equal(t, []string{"Go", "rust", "C"}, v.Data())
+
+	empty := New[string]()
+	assert.Equal(t, 0, empty.Unique(strings.EqualFold))
+}
+
+func TestUniqueComparable(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+		removed  int
+	}{
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{"scattered duplicates", []int{3, 1, 3, 2, 1}, []int{3, 1, 2}, 2},
+		{"all equal", []int{7, 7, 7}, []int{7}, 2},
+		{"empty", []int{}, []int{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New[int](FromSlice(tt.input))
+			assert.Equal(t, tt.removed, UniqueComparable(v))
+			assert.Equal(t, tt.expected, v.Data())
+		})
+	}
+}
+
+func TestDedupAdjacent(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	v := New[int](WithValues(1, 1, 2, 3, 3, 3, 1))
+	assert.Equal(t, 3, v.DedupAdjacent(eq))
+	assert.Equal(t, []int{1, 2, 3, 1}, v.Data())
+
+	sorted := New[int](WithValues(1, 1, 2, 2, 3))
+	assert.Equal(t, 2, sorted.DedupAdjacent(eq))
+	assert.Equal(t, []int{1, 2, 3}, sorted.Data())
+
+	assert.Equal(t, 0, New[int]().DedupAdjacent(eq))
+}
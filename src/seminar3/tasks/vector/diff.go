@@ -0,0 +1,115 @@
+package vector
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPatchMismatch is returned when a patch does not fit the vector it is applied to
+var ErrPatchMismatch = errors.New("patch does not match vector")
+
+// EditKind describes a single step of a diff
+type EditKind int
+
+const (
+	// EditKeep keeps the next element of the old vector
+	EditKeep EditKind = iota
+	// EditInsert inserts value
+	EditInsert
+	// EditDelete removes the next element of the old vector
+	EditDelete
+)
+
+// String returns the name of the edit kind
+func (k EditKind) String() string {
+	switch k {
+	case EditKeep:
+		return "Keep"
+	case EditInsert:
+		return "Insert"
+	case EditDelete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
+// Edit is a single step of a diff; Value is the kept, inserted or deleted element
+type Edit[T any] struct {
+	Kind  EditKind
+	Value T
+}
+
+// Diff returns a shortest edit script turning old into new, based on the longest common subsequence
+// of the two vectors under eq. Deletions are listed before insertions at the same position
+func Diff[T any](old, new *Vector[T], eq func(a, b T) bool) []Edit[T] {
+	a, b := old.elements(), new.elements()
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if eq(a[i], b[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]Edit[T], 0, len(a)+len(b)-lcs[0][0])
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case eq(a[i], b[j]):
+			edits = append(edits, Edit[T]{EditKeep, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, Edit[T]{EditDelete, a[i]})
+			i++
+		default:
+			edits = append(edits, Edit[T]{EditInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		edits = append(edits, Edit[T]{EditDelete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		edits = append(edits, Edit[T]{EditInsert, b[j]})
+	}
+	return edits
+}
+
+// ApplyPatch returns a new vector produced by applying patch to old
+// Keep and delete steps consume the elements of old in order and must consume all of them
+func ApplyPatch[T any](old *Vector[T], patch []Edit[T]) (*Vector[T], error) {
+	source := old.elements()
+	result := New[T](WithCapacity[T](len(source)))
+
+	i := 0
+	for step, edit := range patch {
+		switch edit.Kind {
+		case EditKeep, EditDelete:
+			if i >= len(source) {
+				return nil, fmt.Errorf("%w: step %d %s past the end", ErrPatchMismatch, step, edit.Kind)
+			}
+			if edit.Kind == EditKeep {
+				_ = result.PushBack(source[i])
+			}
+			i++
+		case EditInsert:
+			_ = result.PushBack(edit.Value)
+		default:
+			return nil, fmt.Errorf("%w: step %d has unknown kind %d", ErrPatchMismatch, step, edit.Kind)
+		}
+	}
+	if i != len(source) {
+		return nil, fmt.Errorf("%w: %d elements left unconsumed", ErrPatchMismatch, len(source)-i)
+	}
+	return result, nil
+}
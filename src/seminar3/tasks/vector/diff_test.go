@@ -0,0 +1,93 @@
+package vector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func eqString(a, b string) bool { return a == b }
+
+func TestDiff(t *testing.T) {
+	t.Run("Shortest edit script", func(t *testing.T) {
+		old := New[string](WithValues(strings.Split("ABCABBA", "")...))
+		new := New[string](WithValues(strings.Split("CBABAC", "")...))
+
+		edits := Diff(old, new, eqString)
+
+		keeps := 0
+		for _, e := range edits {
+			if e.Kind == EditKeep {
+				keeps++
+			}
+		}
+		assert.Equal(t, 4, keeps, "LCS of ABCABBA and CBABAC has length 4")
+		assert.Len(t, edits, 7+6-4)
+	})
+
+	t.Run("Simple edits", func(t *testing.T) {
+		old := New[string](WithValues("a", "b", "c"))
+		new := New[string](WithValues("a", "x", "c", "d"))
+
+		assert.Equal(t, []Edit[string]{
+			{EditKeep, "a"},
+			{EditDelete, "b"},
+			{EditInsert, "x"},
+			{EditKeep, "c"},
+			{EditInsert, "d"},
+		}, Diff(old, new, eqString))
+	})
+
+	t.Run("Empty vectors", func(t *testing.T) {
+		assert.Empty(t, Diff(New[string](), New[string](), eqString))
+
+		only := New[string](WithValues("a"))
+		assert.Equal(t, []Edit[string]{{EditInsert, "a"}}, Diff(New[string](), only, eqString))
+		assert.Equal(t, []Edit[string]{{EditDelete, "a"}}, Diff(only, New[string](), eqString))
+	})
+}
+
+func TestApplyPatch(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		lines := [][2]string{
+			{"ABCABBA", "CBABAC"},
+			{"kitten", "sitting"},
+			{"", "abc"},
+			{"abc", ""},
+			{"same", "same"},
+		}
+		for _, l := range lines {
+			old := New[string](WithValues(strings.Split(l[0], "")...))
+			new := New[string](WithValues(strings.Split(l[1], "")...))
+
+			patched, err := ApplyPatch(old, Diff(old, new, eqString))
+			assert.NoError(t, err)
+			assert.Equal(t, new.Data(), patched.Data())
+		}
+	})
+
+	t.Run("Patch too long", func(t *testing.T) {
+		old := New[int](WithValues(1))
+		_, err := ApplyPatch(old, []Edit[int]{{EditKeep, 1}, {EditDelete, 2}})
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+	})
+
+	t.Run("Patch too short", func(t *testing.T) {
+		old := New[int](WithValues(1, 2))
+		_, err := ApplyPatch(old, []Edit[int]{{EditKeep, 1}})
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		_, err := ApplyPatch(New[int](), []Edit[int]{{EditKind(42), 0}})
+		assert.ErrorIs(t, err, ErrPatchMismatch)
+	})
+}
+
+func TestEditKindString(t *testing.T) {
+	assert.Equal(t, "Keep", EditKeep.String())
+	assert.Equal(t, "Insert", EditInsert.String())
+	assert.Equal(t, "Delete", EditDelete.String())
+	assert.Equal(t, "Unknown", EditKind(42).String())
+}
@@ -0,0 +1,35 @@
+package vector
+
+// Fill overwrites every current element with value
+func (v *Vector[T]) Fill(value T) {
+	v.detach()
+	for i := 0; i < v.size; i++ {
+		v.data[i] = value
+		v.notify(OpSet, i, value)
+	}
+}
+
+// Generate replaces the contents of the vector with n elements produced by f from their index
+func (v *Vector[T]) Generate(n int, f func(i int) T) error {
+	values := make([]T, max(n, 0))
+	for i := range values {
+		values[i] = f(i)
+	}
+	return v.Assign(values...)
+}
+
+// Generate creates a new vector of n elements produced by f from their index
+// If n exceeds the maximum capacity set by the options, f is not called and ErrCapacityExceeded is returned
+func Generate[T any](n int, f func(i int) T, options ...Option[T]) (*Vector[T], error) {
+	v := New[T](options...)
+	if err := v.checkCapacity(n); err != nil {
+		return nil, err
+	}
+	v.Reserve(n)
+	for i := 0; i < n; i++ {
+		if err := v.PushBack(f(i)); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
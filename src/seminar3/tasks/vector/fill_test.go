@@ -0,0 +1,51 @@
+package vector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFill(t *testing.T) {
+	v := New[int](WithCapacity[int](5))
+	_ = v.Assign(1, 2, 3)
+
+	v.Fill(9)
+	assert.Equal(t, []int{9, 9, 9}, v.Data())
+	assert.Equal(t, 5, v.Capacity())
+
+	empty := New[int]()
+	empty.Fill(1)
+	assert.True(t, empty.Empty())
+}
+
+func TestGenerateMethod(t *testing.T) {
+	v := New[int](WithValues(100, 200, 300, 400))
+
+	err := v.Generate(3, func(i int) int { return i * i })
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 4}, v.Data())
+
+	bounded := New[int](WithMaxCapacity[int](2))
+	assert.ErrorIs(t, bounded.Generate(3, func(i int) int { return i }), ErrCapacityExceeded)
+}
+
+func TestGenerate(t *testing.T) {
+	v, err := Generate(4, func(i int) string { return strings.Repeat("*", i+1) })
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"*", "**", "***", "****"}, v.Data())
+	assert.Equal(t, 4, v.Capacity())
+
+	for _, n := range []int{0, -1} {
+		empty, err := Generate(n, func(i int) int { return i })
+		assert.NoError(t, err)
+		assert.True(t, empty.Empty())
+	}
+
+	calls := 0
+	bounded, err := Generate(5, func(i int) int { calls++; return i }, WithMaxCapacity[int](3))
+	assert.ErrorIs(t, err, ErrCapacityExceeded)
+	assert.Nil(t, bounded)
+	assert.Zero(t, calls)
+}
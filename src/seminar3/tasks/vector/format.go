@@ -0,0 +1,54 @@
+package vector
+
+import (
+	"fmt"
+	"io"
+)
+
+// WithStringer returns an option to customize how elements are rendered by String and the %v and %s verbs
+func WithStringer[T any](stringer func(T) string) Option[T] {
+	return func(v *Vector[T]) {
+		v.stringer = stringer
+	}
+}
+
+// WithStringLimit returns an option to print at most limit elements in String and the %v and %s verbs.
+// The omitted tail is shown as "…"; a non-positive limit prints every element
+func WithStringLimit[T any](limit int) Option[T] {
+	return func(v *Vector[T]) {
+		v.stringLimit = max(limit, 0)
+	}
+}
+
+// Format implements fmt.Formatter.
+// %v and %s print the compact form Vector[a b c], limited by WithStringLimit,
+// %+v additionally prints size and capacity.
+// Any other verb, together with its flags, width and precision, is applied to each element
+func (v *Vector[T]) Format(f fmt.State, verb rune) {
+	io.WriteString(f, "Vector[")
+
+	elementFormat := fmt.FormatString(f, verb)
+	if verb == 's' {
+		elementFormat = "%v"
+	}
+	compact := verb == 'v' || verb == 's'
+	for i, value := range v.elements() {
+		if i > 0 {
+			io.WriteString(f, " ")
+		}
+		if compact && v.stringLimit > 0 && i == v.stringLimit {
+			io.WriteString(f, "…")
+			break
+		}
+		if v.stringer != nil && compact {
+			io.WriteString(f, v.stringer(value))
+			continue
+		}
+		fmt.Fprintf(f, elementFormat, value)
+	}
+	io.WriteString(f, "]")
+
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, " (size=%d, capacity=%d)", v.size, v.capacity)
+	}
+}
@@ -0,0 +1,84 @@
+package vector
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	v := New[int](WithCapacity[int](4))
+	v.Assign(10, 255, 3)
+
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{"compact", "%v", "Vector[10 255 3]"},
+		{"string verb", "%s", "Vector[10 255 3]"},
+		{"with size and capacity", "%+v", "Vector[10 255 3] (size=3, capacity=4)"},
+		{"hex elements", "%x", "Vector[a ff 3]"},
+		{"padded elements", "%03d", "Vector[010 255 003]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fmt.Sprintf(tt.format, v))
+		})
+	}
+}
+
+func TestFormatEmpty(t *testing.T) {
+	v := New[string]()
+	assert.Equal(t, "Vector[]", fmt.Sprintf("%v", v))
+	assert.Equal(t, "Vector[] (size=0, capacity=0)", fmt.Sprintf("%+v", v))
+}
+
+func TestWithStringer(t *testing.T) {
+	truncate := func(s string) string {
+		if len(s) > 3 {
+			return s[:3] + "…"
+		}
+		return s
+	}
+	v := New[string](WithStringer(truncate), WithValues("go", "golang", "gopher"))
+
+	assert.Equal(t, "Vector[go gol… gop…]", v.String())
+	assert.Equal(t, "Vector[go gol… gop…]", fmt.Sprintf("%s", v))
+	assert.Equal(t, `Vector["go" "golang" "gopher"]`, fmt.Sprintf("%q", v))
+}
+
+func TestWithStringLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    int
+		values   []int
+		expected string
+	}{
+		{"truncated", 2, []int{1, 2, 3, 4}, "Vector[1 2 …]"},
+		{"exactly at limit", 3, []int{1, 2, 3}, "Vector[1 2 3]"},
+		{"below limit", 5, []int{1, 2}, "Vector[1 2]"},
+		{"no limit", 0, []int{1, 2, 3}, "Vector[1 2 3]"},
+		{"negative limit", -1, []int{1, 2, 3}, "Vector[1 2 3]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New[int](WithStringLimit[int](tt.limit), WithValues(tt.values...))
+			assert.Equal(t, tt.expected, v.String())
+		})
+	}
+
+	t.Run("Combined with stringer and size", func(t *testing.T) {
+		v := New[int](
+			WithStringLimit[int](1),
+			WithStringer(func(x int) string { return fmt.Sprintf("#%d", x) }),
+			WithValues(7, 8, 9),
+		)
+		assert.Equal(t, "Vector[#7 …]", fmt.Sprintf("%s", v))
+		assert.Equal(t, "Vector[#7 …] (size=3, capacity=3)", fmt.Sprintf("%+v", v))
+		assert.Equal(t, "Vector[7 8 9]", fmt.Sprintf("%d", v))
+	})
+}
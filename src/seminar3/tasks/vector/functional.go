@@ -0,0 +1,68 @@
+package vector
+
+// Map returns a new vector with f applied to every element of v
+func Map[T, U any](v *Vector[T], f func(T) U) *Vector[U] {
+	result := New[U](WithCapacity[U](v.Size()))
+	for _, value := range v.elements() {
+		_ = result.PushBack(f(value))
+	}
+	return result
+}
+
+// Filter returns a new vector containing the elements that satisfy pred
+func (v *Vector[T]) Filter(pred func(T) bool) *Vector[T] {
+	result := New[T]()
+	for _, value := range v.elements() {
+		if pred(value) {
+			_ = result.PushBack(value)
+		}
+	}
+	return result
+}
+
+// RemoveIf removes all elements satisfying pred in place, keeping the order of the rest,
+// and returns the number of removed elements
+func (v *Vector[T]) RemoveIf(pred func(T) bool) int {
+	return v.compact(func(value T, _ []T) bool {
+		return !pred(value)
+	})
+}
+
+// compact keeps, in order, the elements for which keep returns true and drops the rest.
+// keep receives the candidate and the elements kept so far
+func (v *Vector[T]) compact(keep func(value T, kept []T) bool) int {
+	v.detach()
+
+	type erased struct {
+		index int
+		value T
+	}
+	var removed []erased
+
+	kept := 0
+	for i := 0; i < v.size; i++ {
+		value := v.data[i]
+		if keep(value, v.data[:kept]) {
+			v.data[kept] = value
+			kept++
+		} else if len(v.observers) > 0 {
+			removed = append(removed, erased{kept, value})
+		}
+	}
+
+	count := v.size - kept
+	v.truncate(kept)
+	for _, e := range removed {
+		v.notify(OpErase, e.index, e.value)
+	}
+	return count
+}
+
+// Reduce folds the elements of v into a single value starting from initial
+func Reduce[T, A any](v *Vector[T], initial A, f func(acc A, value T) A) A {
+	acc := initial
+	for _, value := range v.elements() {
+		acc = f(acc, value)
+	}
+	return acc
+}
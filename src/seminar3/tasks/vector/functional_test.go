@@ -0,0 +1,79 @@
+package vector
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("Change type", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		result := Map(v, strconv.Itoa)
+		assert.Equal(t, []string{"1", "2", "3"}, result.Data())
+		assert.Equal(t, 3, result.Capacity())
+	})
+
+	t.Run("Empty vector", func(t *testing.T) {
+		result := Map(New[int](), func(x int) int { return x * 2 })
+		assert.True(t, result.Empty())
+	})
+
+	t.Run("Source is untouched", func(t *testing.T) {
+		v := New[int](WithValues(1, 2))
+		Map(v, func(x int) int { return x * 10 })
+		assert.Equal(t, []int{1, 2}, v.Data())
+	})
+}
+
+func TestFilter(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5, 6))
+
+	even := v.Filter(func(x int) bool { return x%2 == 0 })
+	assert.Equal(t, []int{2, 4, 6}, even.Data())
+	assert.Equal(t, 6, v.Size())
+
+	none := v.Filter(func(x int) bool { return x > 10 })
+	assert.True(t, none.Empty())
+}
+
+func TestRemoveIf(t *testing.T) {
+	t.Run("Removes matching", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3, 4, 5, 6))
+		removed := v.RemoveIf(func(x int) bool { return x%2 == 0 })
+		assert.Equal(t, 3, removed)
+		assert.Equal(t, []int{1, 3, 5}, v.Data())
+		assert.Equal(t, 6, v.Capacity())
+	})
+
+	t.Run("Nothing matches", func(t *testing.T) {
+		v := New[int](WithValues(1, 3))
+		assert.Equal(t, 0, v.RemoveIf(func(x int) bool { return x > 10 }))
+		assert.Equal(t, []int{1, 3}, v.Data())
+	})
+
+	t.Run("Everything matches", func(t *testing.T) {
+		v := New[string](WithValues("a", "b"))
+		assert.Equal(t, 2, v.RemoveIf(func(string) bool { return true }))
+		assert.True(t, v.Empty())
+	})
+
+	t.Run("Zeroes removed tail", func(t *testing.T) {
+		v := New[*int](WithValues(new(int), nil, new(int)))
+		v.RemoveIf(func(p *int) bool { return p == nil })
+		assert.Nil(t, v.data[2])
+	})
+}
+
+func TestReduce(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4))
+
+	sum := Reduce(v, 0, func(acc, x int) int { return acc + x })
+	assert.Equal(t, 10, sum)
+
+	joined := Reduce(v, "", func(acc string, x int) string { return acc + strconv.Itoa(x) })
+	assert.Equal(t, "1234", joined)
+
+	assert.Equal(t, 42, Reduce(New[int](), 42, func(acc, x int) int { return acc + x }))
+}
@@ -0,0 +1,28 @@
+package vector
+
+// Count returns the number of elements satisfying pred
+func (v *Vector[T]) Count(pred func(T) bool) int {
+	count := 0
+	for _, value := range v.elements() {
+		if pred(value) {
+			count++
+		}
+	}
+	return count
+}
+
+// GroupBy splits the elements of v into vectors by the key returned for each element
+// Elements inside every group keep their original order
+func GroupBy[T any, K comparable](v *Vector[T], key func(T) K) map[K]*Vector[T] {
+	groups := make(map[K]*Vector[T])
+	for _, value := range v.elements() {
+		k := key(value)
+		group, ok := groups[k]
+		if !ok {
+			group = New[T]()
+			groups[k] = group
+		}
+		_ = group.PushBack(value)
+	}
+	return groups
+}
@@ -0,0 +1,39 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5, 6))
+
+	assert.Equal(t, 3, v.Count(func(x int) bool { return x%2 == 0 }))
+	assert.Equal(t, 0, v.Count(func(x int) bool { return x > 10 }))
+	assert.Equal(t, 0, New[int]().Count(func(int) bool { return true }))
+}
+
+func TestGroupBy(t *testing.T) {
+	t.Run("Groups keep order", func(t *testing.T) {
+		v := New[string](WithValues("apple", "bob", "avocado", "cat", "banana"))
+		groups := GroupBy(v, func(s string) byte { return s[0] })
+
+		assert.Len(t, groups, 3)
+		assert.Equal(t, []string{"apple", "avocado"}, groups['a'].Data())
+		assert.Equal(t, []string{"bob", "banana"}, groups['b'].Data())
+		assert.Equal(t, []string{"cat"}, groups['c'].Data())
+	})
+
+	t.Run("Empty vector", func(t *testing.T) {
+		groups := GroupBy(New[int](), func(x int) int { return x })
+		assert.Empty(t, groups)
+	})
+
+	t.Run("Groups are independent of the source", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		groups := GroupBy(v, func(x int) bool { return x%2 == 1 })
+		v.Set(0, 100)
+		assert.Equal(t, []int{1, 3}, groups[true].Data())
+	})
+}
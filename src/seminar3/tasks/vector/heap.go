@@ -0,0 +1,73 @@
+package vector
+
+// MakeHeap rearranges the vector into a max-heap ordered by cmp, so the largest element is at the front
+func (v *Vector[T]) MakeHeap(cmp func(a, b T) int) {
+	data := v.Data()
+	for i := len(data)/2 - 1; i >= 0; i-- {
+		siftDown(data, i, cmp)
+	}
+}
+
+// PushHeap appends value to a heap ordered by cmp and restores the heap property
+func (v *Vector[T]) PushHeap(value T, cmp func(a, b T) int) error {
+	if err := v.PushBack(value); err != nil {
+		return err
+	}
+	siftUp(v.Data(), v.size-1, cmp)
+	return nil
+}
+
+// PopHeap removes and returns the largest element of a heap ordered by cmp
+func (v *Vector[T]) PopHeap(cmp func(a, b T) int) (T, error) {
+	if v.size == 0 {
+		var zero T
+		return zero, ErrEmptyVector
+	}
+	data := v.Data()
+	last := len(data) - 1
+	data[0], data[last] = data[last], data[0]
+	siftDown(data[:last], 0, cmp)
+	return v.PopBackValue()
+}
+
+// IsHeap reports whether the vector is a max-heap ordered by cmp
+func (v *Vector[T]) IsHeap(cmp func(a, b T) int) bool {
+	data := v.elements()
+	for i := 1; i < len(data); i++ {
+		if cmp(data[(i-1)/2], data[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// siftUp moves the element at index i towards the root until its parent is not smaller
+func siftUp[T any](data []T, i int, cmp func(a, b T) int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if cmp(data[parent], data[i]) >= 0 {
+			return
+		}
+		data[parent], data[i] = data[i], data[parent]
+		i = parent
+	}
+}
+
+// siftDown moves the element at index i towards the leaves until both children are not larger
+func siftDown[T any](data []T, i int, cmp func(a, b T) int) {
+	for {
+		largest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(data) && cmp(data[left], data[largest]) > 0 {
+			largest = left
+		}
+		if right < len(data) && cmp(data[right], data[largest]) > 0 {
+			largest = right
+		}
+		if largest == i {
+			return
+		}
+		data[i], data[largest] = data[largest], data[i]
+		i = largest
+	}
+}
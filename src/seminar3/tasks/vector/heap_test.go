@@ -0,0 +1,66 @@
+package vector
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeHeap(t *testing.T) {
+	v := New[int](WithValues(3, 1, 4, 1, 5, 9, 2, 6))
+	assert.False(t, v.IsHeap(cmp.Compare[int]))
+
+	v.MakeHeap(cmp.Compare[int])
+	assert.True(t, v.IsHeap(cmp.Compare[int]))
+	assert.Equal(t, 9, v.MustFront())
+	assert.Equal(t, 8, v.Size())
+}
+
+func TestHeapOperations(t *testing.T) {
+	t.Run("Pops in descending order", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		v := New[int]()
+		for range 50 {
+			assert.NoError(t, v.PushHeap(rng.IntN(100), cmp.Compare[int]))
+			assert.True(t, v.IsHeap(cmp.Compare[int]))
+		}
+
+		prev := 100
+		for !v.Empty() {
+			top, err := v.PopHeap(cmp.Compare[int])
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, top, prev)
+			assert.True(t, v.IsHeap(cmp.Compare[int]))
+			prev = top
+		}
+	})
+
+	t.Run("Min-heap with reversed cmp", func(t *testing.T) {
+		reversed := func(a, b int) int { return cmp.Compare(b, a) }
+		v := New[int](WithValues(5, 3, 8, 1))
+		v.MakeHeap(reversed)
+
+		top, err := v.PopHeap(reversed)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, top)
+		assert.Equal(t, 3, v.MustFront())
+	})
+
+	t.Run("Pop from empty", func(t *testing.T) {
+		v := New[int]()
+		_, err := v.PopHeap(cmp.Compare[int])
+		assert.ErrorIs(t, err, ErrEmptyVector)
+	})
+
+	t.Run("Push into full vector", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](1), WithValues(1))
+		assert.ErrorIs(t, v.PushHeap(2, cmp.Compare[int]), ErrCapacityExceeded)
+		assert.Equal(t, []int{1}, v.Data())
+	})
+
+	t.Run("Empty vector is a heap", func(t *testing.T) {
+		assert.True(t, New[int]().IsHeap(cmp.Compare[int]))
+	})
+}
@@ -0,0 +1,161 @@
+package vector
+
+import (
+	"fmt"
+	"iter"
+)
+
+const (
+	trieBits  = 5
+	trieWidth = 1 << trieBits
+	trieMask  = trieWidth - 1
+)
+
+// trieNode is a node of the persistent trie: inner nodes hold children, leaves hold values
+type trieNode[T any] struct {
+	children []*trieNode[T]
+	values   []T
+}
+
+// ImmutableVector is a persistent vector: every update returns a new version
+// that shares all untouched nodes with the previous one.
+// It is a 32-way trie, so At, PushBack and Set take O(log32 n) time
+// and copy only the nodes on the path to the changed element.
+// The zero value is an empty vector ready to use
+type ImmutableVector[T any] struct {
+	root  *trieNode[T]
+	size  int
+	shift int
+}
+
+// NewImmutable creates a persistent vector holding values
+func NewImmutable[T any](values ...T) ImmutableVector[T] {
+	var v ImmutableVector[T]
+	for _, value := range values {
+		v = v.PushBack(value)
+	}
+	return v
+}
+
+// Size returns the number of elements in the vector
+func (v ImmutableVector[T]) Size() int {
+	return v.size
+}
+
+// Empty returns true if the vector is empty
+func (v ImmutableVector[T]) Empty() bool {
+	return v.size == 0
+}
+
+// At returns the element at the specified index with bounds checking
+func (v ImmutableVector[T]) At(index int) (T, error) {
+	if index < 0 || index >= v.size {
+		var zero T
+		return zero, fmt.Errorf("%w: index %d, size %d", ErrIndexOutOfRange, index, v.size)
+	}
+	return v.leafFor(index).values[index&trieMask], nil
+}
+
+// PushBack returns a new version of the vector with value appended
+func (v ImmutableVector[T]) PushBack(value T) ImmutableVector[T] {
+	if v.root == nil {
+		return ImmutableVector[T]{root: &trieNode[T]{values: []T{value}}, size: 1}
+	}
+
+	// The trie is full: grow it by one level
+	if v.size == 1<<(v.shift+trieBits) {
+		root := &trieNode[T]{children: []*trieNode[T]{v.root, newTriePath(v.shift, value)}}
+		return ImmutableVector[T]{root: root, size: v.size + 1, shift: v.shift + trieBits}
+	}
+
+	return ImmutableVector[T]{root: pushTrie(v.root, v.shift, v.size, value), size: v.size + 1, shift: v.shift}
+}
+
+// Set returns a new version of the vector with the element at index replaced by value
+func (v ImmutableVector[T]) Set(index int, value T) (ImmutableVector[T], error) {
+	if index < 0 || index >= v.size {
+		return v, fmt.Errorf("%w: index %d, size %d", ErrIndexOutOfRange, index, v.size)
+	}
+	return ImmutableVector[T]{root: setTrie(v.root, v.shift, index, value), size: v.size, shift: v.shift}, nil
+}
+
+// All returns an iterator over index-value pairs of the vector
+func (v ImmutableVector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for start := 0; start < v.size; start += trieWidth {
+			for i, value := range v.leafFor(start).values {
+				if !yield(start+i, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the vector
+func (v ImmutableVector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range v.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ToVector copies the elements into a new mutable vector
+func (v ImmutableVector[T]) ToVector() *Vector[T] {
+	return FromSeq(v.Values(), WithCapacity[T](v.size))
+}
+
+// String returns a string representation of the vector as ImmutableVector[...]
+func (v ImmutableVector[T]) String() string {
+	return "Immutable" + v.ToVector().String()
+}
+
+// leafFor descends to the leaf holding index
+func (v ImmutableVector[T]) leafFor(index int) *trieNode[T] {
+	node := v.root
+	for level := v.shift; level > 0; level -= trieBits {
+		node = node.children[(index>>level)&trieMask]
+	}
+	return node
+}
+
+// newTriePath builds a chain of nodes down to a leaf holding a single value
+func newTriePath[T any](level int, value T) *trieNode[T] {
+	if level == 0 {
+		return &trieNode[T]{values: []T{value}}
+	}
+	return &trieNode[T]{children: []*trieNode[T]{newTriePath(level-trieBits, value)}}
+}
+
+// pushTrie returns a copy of node with value appended at position index
+func pushTrie[T any](node *trieNode[T], level, index int, value T) *trieNode[T] {
+	if level == 0 {
+		return &trieNode[T]{values: append(node.values[:len(node.values):len(node.values)], value)}
+	}
+
+	children := append([]*trieNode[T](nil), node.children...)
+	child := (index >> level) & trieMask
+	if child < len(children) {
+		children[child] = pushTrie(children[child], level-trieBits, index, value)
+	} else {
+		children = append(children, newTriePath(level-trieBits, value))
+	}
+	return &trieNode[T]{children: children}
+}
+
+// setTrie returns a copy of node with the element at index replaced by value
+func setTrie[T any](node *trieNode[T], level, index int, value T) *trieNode[T] {
+	if level == 0 {
+		values := append([]T(nil), node.values...)
+		values[index&trieMask] = value
+		return &trieNode[T]{values: values}
+	}
+
+	children := append([]*trieNode[T](nil), node.children...)
+	child := (index >> level) & trieMask
+	children[child] = setTrie(children[child], level-trieBits, index, value)
+	return &trieNode[T]{children: children}
+}
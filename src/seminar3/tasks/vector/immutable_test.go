@@ -0,0 +1,104 @@
+package vector
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImmutableVectorZeroValue(t *testing.T) {
+	var v ImmutableVector[int]
+	assert.True(t, v.Empty())
+
+	_, err := v.At(0)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	assert.Empty(t, slices.Collect(v.Values()))
+}
+
+func TestImmutableVectorPushBack(t *testing.T) {
+	v0 := NewImmutable[int]()
+	v1 := v0.PushBack(1)
+	v2 := v1.PushBack(2)
+
+	assert.Equal(t, 0, v0.Size())
+	assert.Equal(t, []int{1}, slices.Collect(v1.Values()))
+	assert.Equal(t, []int{1, 2}, slices.Collect(v2.Values()))
+
+	// Branching from an older version must not affect newer ones
+	v2b := v1.PushBack(20)
+	assert.Equal(t, []int{1, 2}, slices.Collect(v2.Values()))
+	assert.Equal(t, []int{1, 20}, slices.Collect(v2b.Values()))
+}
+
+func TestImmutableVectorLarge(t *testing.T) {
+	const n = 40000
+
+	var v ImmutableVector[int]
+	for i := 0; i < n; i++ {
+		v = v.PushBack(i)
+	}
+	assert.Equal(t, n, v.Size())
+
+	for _, i := range []int{0, 31, 32, 1023, 1024, 32767, 32768, n - 1} {
+		val, err := v.At(i)
+		assert.NoError(t, err)
+		assert.Equal(t, i, val)
+	}
+
+	expected := 0
+	for i, val := range v.All() {
+		assert.Equal(t, expected, i)
+		assert.Equal(t, expected, val)
+		expected++
+	}
+	assert.Equal(t, n, expected)
+}
+
+func TestImmutableVectorSet(t *testing.T) {
+	v := NewImmutable(1, 2, 3)
+
+	updated, err := v.Set(1, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 20, 3}, slices.Collect(updated.Values()))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(v.Values()))
+
+	_, err = v.Set(3, 0)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestImmutableVectorStructuralSharing(t *testing.T) {
+	var v ImmutableVector[int]
+	for i := 0; i < 100; i++ {
+		v = v.PushBack(i)
+	}
+
+	updated, err := v.Set(99, -1)
+	assert.NoError(t, err)
+	assert.Same(t, v.root.children[0], updated.root.children[0], "untouched leaves are shared")
+	assert.NotSame(t, v.root.children[3], updated.root.children[3])
+}
+
+func TestImmutableVectorConversions(t *testing.T) {
+	v := NewImmutable("a", "b")
+	assert.Equal(t, []string{"a", "b"}, v.ToVector().Data())
+	assert.Equal(t, "ImmutableVector[a b]", v.String())
+}
+
+func TestImmutableVectorConcurrentReads(t *testing.T) {
+	v := NewImmutable(1, 2, 3)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			next := v.PushBack(g)
+			val, _ := next.At(3)
+			assert.Equal(t, g, val)
+			assert.Equal(t, 3, v.Size())
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,27 @@
+package vector
+
+// WithNegativeIndex returns an option that makes At and Set accept Python-style negative indices,
+// so At(-1) returns the last element
+func WithNegativeIndex[T any]() Option[T] {
+	return func(v *Vector[T]) {
+		v.negativeIndex = true
+	}
+}
+
+// AtWrap is like At but always accepts negative indices counting from the end
+func (v *Vector[T]) AtWrap(index int) (T, error) {
+	index = v.wrapIndex(index)
+	if err := v.checkIndex(index, v.size); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.data[index], nil
+}
+
+// wrapIndex converts a negative index into the matching position from the end
+func (v *Vector[T]) wrapIndex(index int) int {
+	if index < 0 {
+		return index + v.size
+	}
+	return index
+}
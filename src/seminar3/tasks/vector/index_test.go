@@ -0,0 +1,71 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAtWrap(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c"))
+
+	tests := []struct {
+		index    int
+		expected string
+	}{
+		{0, "a"},
+		{2, "c"},
+		{-1, "c"},
+		{-3, "a"},
+	}
+	for _, tt := range tests {
+		val, err := v.AtWrap(tt.index)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, val)
+	}
+
+	_, err := v.AtWrap(-4)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	_, err = v.AtWrap(3)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	_, err = v.At(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange, "At rejects negative indices by default")
+}
+
+func TestAtWrapWithNegativeIndex(t *testing.T) {
+	v := New[int](WithNegativeIndex[int](), WithValues(10, 20, 30))
+
+	val, err := v.AtWrap(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, val)
+
+	for _, index := range []int{-4, -6, 3} {
+		_, err := v.AtWrap(index)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange, "index %d must not be wrapped twice", index)
+	}
+}
+
+func TestWithNegativeIndex(t *testing.T) {
+	v := New[int](WithNegativeIndex[int](), WithValues(1, 2, 3))
+
+	val, err := v.At(-1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, val)
+	assert.Equal(t, 1, v.MustAt(-3))
+
+	assert.NoError(t, v.Set(-2, 20))
+	assert.Equal(t, []int{1, 20, 3}, v.Data())
+
+	_, err = v.At(-4)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	assert.ErrorIs(t, v.Set(-4, 0), ErrIndexOutOfRange)
+}
+
+func TestNegativeIndexEmpty(t *testing.T) {
+	v := New[int](WithNegativeIndex[int]())
+
+	_, err := v.At(-1)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
@@ -0,0 +1,54 @@
+package vector
+
+import "iter"
+
+// All returns an iterator over index-value pairs of the vector
+func (v *Vector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < v.size; i++ {
+			if !yield(i, v.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the vector
+func (v *Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < v.size; i++ {
+			if !yield(v.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach calls fn for every element of the vector in order
+func (v *Vector[T]) ForEach(fn func(value T)) {
+	for i := 0; i < v.size; i++ {
+		fn(v.data[i])
+	}
+}
+
+// ForEachIndexed calls fn for every element with its index until fn returns false
+func (v *Vector[T]) ForEachIndexed(fn func(index int, value T) bool) {
+	for i := 0; i < v.size; i++ {
+		if !fn(i, v.data[i]) {
+			return
+		}
+	}
+}
+
+// FromSeq creates a new vector from the values produced by seq
+// Options are applied before the values are appended; with WithMaxCapacity
+// the sequence is consumed only until the vector is full
+func FromSeq[T any](seq iter.Seq[T], options ...Option[T]) *Vector[T] {
+	v := New[T](options...)
+	for value := range seq {
+		if v.PushBack(value) != nil {
+			break
+		}
+	}
+	return v
+}
@@ -0,0 +1,103 @@
+package vector
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c"))
+
+	var indices []int
+	var values []string
+	for i, val := range v.All() {
+		indices = append(indices, i)
+		values = append(values, val)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
+
+func TestAllEarlyExit(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5))
+
+	var values []int
+	for _, val := range v.All() {
+		if val > 2 {
+			break
+		}
+		values = append(values, val)
+	}
+
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestValues(t *testing.T) {
+	t.Run("Collect values", func(t *testing.T) {
+		v := New[int](WithValues(10, 20, 30))
+		assert.Equal(t, []int{10, 20, 30}, slices.Collect(v.Values()))
+	})
+
+	t.Run("Empty vector", func(t *testing.T) {
+		v := New[int]()
+		assert.Empty(t, slices.Collect(v.Values()))
+	})
+}
+
+func TestForEach(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+
+	sum := 0
+	v.ForEach(func(x int) { sum += x })
+	assert.Equal(t, 6, sum)
+
+	calls := 0
+	New[int]().ForEach(func(int) { calls++ })
+	assert.Equal(t, 0, calls)
+}
+
+func TestForEachIndexed(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c", "d"))
+
+	var visited []string
+	v.ForEachIndexed(func(i int, s string) bool {
+		visited = append(visited, s)
+		return i < 1
+	})
+	assert.Equal(t, []string{"a", "b"}, visited)
+
+	count := 0
+	v.ForEachIndexed(func(int, string) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 4, count)
+}
+
+func TestFromSeq(t *testing.T) {
+	t.Run("From slice values", func(t *testing.T) {
+		v := FromSeq(slices.Values([]int{1, 2, 3}))
+		assert.Equal(t, 3, v.Size())
+		assert.Equal(t, []int{1, 2, 3}, v.Data())
+	})
+
+	t.Run("From map keys", func(t *testing.T) {
+		v := FromSeq(maps.Keys(map[string]int{"x": 1, "y": 2}))
+		assert.ElementsMatch(t, []string{"x", "y"}, v.Data())
+	})
+
+	t.Run("With capacity option", func(t *testing.T) {
+		v := FromSeq(slices.Values([]int{1, 2}), WithCapacity[int](10))
+		assert.Equal(t, 2, v.Size())
+		assert.Equal(t, 10, v.Capacity())
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		v := New[int](WithValues(5, 6, 7))
+		assert.Equal(t, v.Data(), FromSeq(v.Values()).Data())
+	})
+}
@@ -0,0 +1,86 @@
+package vector
+
+import "errors"
+
+// ErrIteratorInvalidated is returned when an iterator is used after its vector was structurally modified
+var ErrIteratorInvalidated = errors.New("iterator invalidated by vector modification")
+
+// Iterator is a cursor over a vector that detects structural changes made behind its back
+type Iterator[T any] struct {
+	vec      *Vector[T]
+	index    int
+	modCount int
+	removed  bool
+	err      error
+}
+
+// Iter returns an iterator positioned before the first element
+func (v *Vector[T]) Iter() *Iterator[T] {
+	return &Iterator[T]{
+		vec:      v,
+		index:    -1,
+		modCount: v.modCount,
+	}
+}
+
+// Next advances the iterator and reports whether there is an element to read
+// It returns false at the end of the vector or if the iterator was invalidated, see Err
+func (it *Iterator[T]) Next() bool {
+	if it.checkValid() != nil {
+		return false
+	}
+	// After Remove the following element has already shifted into the current position
+	if !it.removed && it.index < it.vec.size {
+		it.index++
+	}
+	it.removed = false
+	return it.index < it.vec.size
+}
+
+// Value returns the element at the current position
+func (it *Iterator[T]) Value() (T, error) {
+	var zero T
+	if err := it.checkValid(); err != nil {
+		return zero, err
+	}
+	if it.removed {
+		return zero, ErrIndexOutOfRange
+	}
+	return it.vec.At(it.index)
+}
+
+// Index returns the current position of the iterator
+func (it *Iterator[T]) Index() int {
+	return it.index
+}
+
+// Remove erases the current element; the following call to Next moves to the element after it
+// Removing through the iterator keeps it valid
+func (it *Iterator[T]) Remove() error {
+	if err := it.checkValid(); err != nil {
+		return err
+	}
+	if it.removed {
+		return ErrIndexOutOfRange
+	}
+	if err := it.vec.Erase(it.index); err != nil {
+		return err
+	}
+
+	it.removed = true
+	it.modCount = it.vec.modCount
+	return nil
+}
+
+// Err returns ErrIteratorInvalidated if the vector was modified outside of the iterator
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// checkValid compares the modification counters and remembers the failure
+func (it *Iterator[T]) checkValid() error {
+	if it.err == nil && it.modCount != it.vec.modCount {
+		it.err = ErrIteratorInvalidated
+	}
+	return it.err
+}
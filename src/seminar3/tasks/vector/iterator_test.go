@@ -0,0 +1,111 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterator(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c"))
+
+	var values []string
+	var indices []int
+	it := v.Iter()
+	for it.Next() {
+		val, err := it.Value()
+		assert.NoError(t, err)
+		values = append(values, val)
+		indices = append(indices, it.Index())
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+	assert.Equal(t, []int{0, 1, 2}, indices)
+	assert.False(t, it.Next())
+}
+
+func TestIteratorEmpty(t *testing.T) {
+	it := New[int]().Iter()
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+
+	_, err := it.Value()
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestIteratorRemove(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5, 6))
+
+	it := v.Iter()
+	var seen []int
+	for it.Next() {
+		val, _ := it.Value()
+		seen = append(seen, val)
+		if val%2 == 0 {
+			assert.NoError(t, it.Remove())
+		}
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, seen)
+	assert.Equal(t, []int{1, 3, 5}, v.Data())
+}
+
+func TestIteratorRemoveTwice(t *testing.T) {
+	v := New[int](WithValues(1, 2))
+
+	it := v.Iter()
+	assert.True(t, it.Next())
+	assert.NoError(t, it.Remove())
+	assert.ErrorIs(t, it.Remove(), ErrIndexOutOfRange)
+
+	_, err := it.Value()
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	assert.Equal(t, []int{2}, v.Data())
+}
+
+func TestIteratorInvalidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(v *Vector[int])
+	}{
+		{"push back", func(v *Vector[int]) { v.PushBack(4) }},
+		{"pop back", func(v *Vector[int]) { _ = v.PopBack() }},
+		{"insert", func(v *Vector[int]) { _ = v.Insert(0, 0) }},
+		{"erase", func(v *Vector[int]) { _ = v.Erase(0) }},
+		{"clear", func(v *Vector[int]) { v.Clear() }},
+		{"assign", func(v *Vector[int]) { v.Assign(7, 8, 9) }},
+		{"resize", func(v *Vector[int]) { v.Resize(10, 0) }},
+		{"swap", func(v *Vector[int]) { v.Swap(New[int]()) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New[int](WithValues(1, 2, 3))
+			it := v.Iter()
+			assert.True(t, it.Next())
+
+			tt.modify(v)
+
+			_, err := it.Value()
+			assert.ErrorIs(t, err, ErrIteratorInvalidated)
+			assert.False(t, it.Next())
+			assert.ErrorIs(t, it.Err(), ErrIteratorInvalidated)
+			assert.ErrorIs(t, it.Remove(), ErrIteratorInvalidated)
+		})
+	}
+}
+
+func TestIteratorValueUpdatesAreAllowed(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+
+	it := v.Iter()
+	assert.True(t, it.Next())
+	v.Data()[1] = 20
+
+	assert.True(t, it.Next())
+	val, err := it.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, 20, val)
+}
@@ -0,0 +1,25 @@
+package vector
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the vector as a plain JSON array
+func (v *Vector[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.elements())
+}
+
+// UnmarshalJSON decodes a JSON array into the vector
+// The existing capacity is reused when it is large enough to hold the decoded values
+func (v *Vector[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	return v.Assign(values...)
+}
@@ -0,0 +1,87 @@
+package vector
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("Ints", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		data, err := json.Marshal(v)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[1,2,3]`, string(data))
+	})
+
+	t.Run("Empty vector", func(t *testing.T) {
+		data, err := json.Marshal(New[string]())
+		assert.NoError(t, err)
+		assert.Equal(t, `[]`, string(data))
+	})
+
+	t.Run("As struct field", func(t *testing.T) {
+		type payload struct {
+			Names *Vector[string] `json:"names"`
+		}
+		data, err := json.Marshal(payload{Names: New[string](WithValues("a", "b"))})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"names":["a","b"]}`, string(data))
+	})
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Run("Ints", func(t *testing.T) {
+		v := New[int]()
+		err := json.Unmarshal([]byte(`[4, 5, 6]`), v)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 5, 6}, v.Data())
+	})
+
+	t.Run("Keeps capacity", func(t *testing.T) {
+		v := New[int](WithCapacity[int](10))
+		err := json.Unmarshal([]byte(`[1, 2]`), v)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v.Size())
+		assert.Equal(t, 10, v.Capacity())
+	})
+
+	t.Run("Null is a no-op", func(t *testing.T) {
+		v := New[int](WithValues(1))
+		err := json.Unmarshal([]byte(`null`), v)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1}, v.Data())
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		v := New[int]()
+		err := json.Unmarshal([]byte(`{"a": 1}`), v)
+		assert.Error(t, err)
+	})
+}
+
+func TestJSONNestedVectors(t *testing.T) {
+	v := New[Vector[int]](WithValues(
+		*New[int](WithValues(1, 2)),
+		*New[int](),
+		*New[int](WithValues(3)),
+	))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[[1,2],[],[3]]`, string(data))
+
+	decoded := New[Vector[int]]()
+	err = json.Unmarshal(data, decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, decoded.Size())
+
+	inner, err := decoded.At(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, inner.Data())
+
+	inner, err = decoded.At(1)
+	assert.NoError(t, err)
+	assert.True(t, inner.Empty())
+}
@@ -0,0 +1,36 @@
+package vector
+
+// MustAt is like At but panics if the index is out of range
+func (v *Vector[T]) MustAt(index int) T {
+	return must(v.At(index))
+}
+
+// MustFront is like Front but panics if the vector is empty
+func (v *Vector[T]) MustFront() T {
+	return must(v.Front())
+}
+
+// MustBack is like Back but panics if the vector is empty
+func (v *Vector[T]) MustBack() T {
+	return must(v.Back())
+}
+
+// MustPopBack removes and returns the last element, panicking if the vector is empty
+func (v *Vector[T]) MustPopBack() T {
+	return must(v.PopBackValue())
+}
+
+// MustSet is like Set but panics if the index is out of range
+func (v *Vector[T]) MustSet(index int, value T) {
+	if err := v.Set(index, value); err != nil {
+		panic(err)
+	}
+}
+
+// must unwraps a (value, error) pair, panicking on error
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
@@ -0,0 +1,30 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMustAccessors(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+
+	assert.Equal(t, 2, v.MustAt(1))
+	assert.Equal(t, 1, v.MustFront())
+	assert.Equal(t, 3, v.MustBack())
+	assert.Equal(t, 3, v.MustPopBack())
+	assert.Equal(t, 2, v.Size())
+
+	v.MustSet(0, 10)
+	assert.Equal(t, 10, v.MustAt(0))
+}
+
+func TestMustPanics(t *testing.T) {
+	v := New[int]()
+
+	assert.PanicsWithError(t, ErrEmptyVector.Error(), func() { v.MustFront() })
+	assert.PanicsWithError(t, ErrEmptyVector.Error(), func() { v.MustBack() })
+	assert.PanicsWithError(t, ErrEmptyVector.Error(), func() { v.MustPopBack() })
+	assert.Panics(t, func() { v.MustAt(0) })
+	assert.Panics(t, func() { v.MustSet(0, 1) })
+}
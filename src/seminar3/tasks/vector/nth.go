@@ -0,0 +1,48 @@
+package vector
+
+import "math/rand/v2"
+
+// NthElement rearranges the elements so that the element at index n is the one that would be there
+// if the vector were sorted by cmp. Elements before n are not greater and elements after n are not less
+// than it. Runs in expected linear time
+func (v *Vector[T]) NthElement(n int, cmp func(a, b T) int) error {
+	if err := v.checkIndex(n, v.size); err != nil {
+		return err
+	}
+
+	data := v.Data()
+	lo, hi := 0, len(data)
+	for hi-lo > 1 {
+		lt, gt := partition3(data[lo:hi], cmp)
+		switch {
+		case n < lo+lt:
+			hi = lo + lt
+		case n >= lo+gt:
+			lo += gt
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// partition3 splits data around a random pivot into elements less than, equal to and greater than it
+// and returns the bounds of the equal part
+func partition3[T any](data []T, cmp func(a, b T) int) (lt, gt int) {
+	pivot := data[rand.IntN(len(data))]
+	lt, i, gt := 0, 0, len(data)
+	for i < gt {
+		switch c := cmp(data[i], pivot); {
+		case c < 0:
+			data[lt], data[i] = data[i], data[lt]
+			lt++
+			i++
+		case c > 0:
+			gt--
+			data[i], data[gt] = data[gt], data[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
@@ -0,0 +1,49 @@
+package vector
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNthElement(t *testing.T) {
+	t.Run("Matches sorted order", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(3, 4))
+		values := make([]int, 200)
+		for i := range values {
+			values[i] = rng.IntN(50)
+		}
+		sorted := slices.Sorted(slices.Values(values))
+
+		for _, n := range []int{0, 1, 57, 100, 199} {
+			v := New[int](WithValues(values...))
+			assert.NoError(t, v.NthElement(n, cmp.Compare[int]))
+
+			data := v.Data()
+			assert.Equal(t, sorted[n], data[n])
+			for i := range n {
+				assert.LessOrEqual(t, data[i], data[n])
+			}
+			for i := n + 1; i < len(data); i++ {
+				assert.GreaterOrEqual(t, data[i], data[n])
+			}
+			assert.ElementsMatch(t, values, data)
+		}
+	})
+
+	t.Run("Single element", func(t *testing.T) {
+		v := New[int](WithValues(7))
+		assert.NoError(t, v.NthElement(0, cmp.Compare[int]))
+		assert.Equal(t, []int{7}, v.Data())
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		assert.ErrorIs(t, v.NthElement(3, cmp.Compare[int]), ErrIndexOutOfRange)
+		assert.ErrorIs(t, v.NthElement(-1, cmp.Compare[int]), ErrIndexOutOfRange)
+		assert.ErrorIs(t, New[int]().NthElement(0, cmp.Compare[int]), ErrIndexOutOfRange)
+	})
+}
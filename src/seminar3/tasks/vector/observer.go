@@ -0,0 +1,85 @@
+package vector
+
+// Operation describes a change reported to OnChange subscribers
+type Operation int
+
+const (
+	// OpPushBack reports an element appended at index
+	OpPushBack Operation = iota
+	// OpInsert reports an element inserted at index, shifting the following ones
+	OpInsert
+	// OpErase reports an element removed from index, shifting the following ones
+	OpErase
+	// OpSet reports the element at index being replaced with value
+	OpSet
+	// OpResize reports the vector resized to index elements, new slots are filled with value
+	OpResize
+	// OpClear reports all elements being removed
+	OpClear
+)
+
+// String returns the name of the operation
+func (op Operation) String() string {
+	switch op {
+	case OpPushBack:
+		return "PushBack"
+	case OpInsert:
+		return "Insert"
+	case OpErase:
+		return "Erase"
+	case OpSet:
+		return "Set"
+	case OpResize:
+		return "Resize"
+	case OpClear:
+		return "Clear"
+	default:
+		return "Unknown"
+	}
+}
+
+// observer is a subscription created by OnChange
+type observer[T any] struct {
+	id int
+	fn func(op Operation, index int, value T)
+}
+
+// OnChange subscribes fn to changes of the vector and returns a function that cancels the subscription.
+// Replaying the reported operations in order on a copy reproduces the vector. Bulk operations
+// are reported element by element; Assign and Swap are reported as OpClear followed by OpPushBack
+// for every element. Reordering (Sort, Reverse, Shuffle, ...) and writes through Data are not reported.
+// fn is called synchronously after the change and must not modify the vector
+func (v *Vector[T]) OnChange(fn func(op Operation, index int, value T)) (cancel func()) {
+	id := v.nextObserver
+	v.nextObserver++
+	v.observers = append(v.observers, observer[T]{id: id, fn: fn})
+
+	return func() {
+		for i, o := range v.observers {
+			if o.id == id {
+				v.observers = append(v.observers[:i:i], v.observers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// notify delivers a change to all subscribers
+func (v *Vector[T]) notify(op Operation, index int, value T) {
+	for _, o := range v.observers {
+		o.fn(op, index, value)
+	}
+}
+
+// notifyReset reports the whole content as replaced
+func (v *Vector[T]) notifyReset() {
+	if len(v.observers) == 0 {
+		return
+	}
+
+	var zero T
+	v.notify(OpClear, 0, zero)
+	for i, value := range v.elements() {
+		v.notify(OpPushBack, i, value)
+	}
+}
@@ -0,0 +1,127 @@
+package vector
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mirror replays reported operations on a plain slice
+func mirror[T any](v *Vector[T]) *[]T {
+	replica := slices.Clone(v.Data())
+	v.OnChange(func(op Operation, index int, value T) {
+		switch op {
+		case OpPushBack:
+			replica = append(replica, value)
+		case OpInsert:
+			replica = slices.Insert(replica, index, value)
+		case OpErase:
+			replica = slices.Delete(replica, index, index+1)
+		case OpSet:
+			replica[index] = value
+		case OpResize:
+			for len(replica) < index {
+				replica = append(replica, value)
+			}
+			replica = replica[:index]
+		case OpClear:
+			replica = replica[:0]
+		}
+	})
+	return &replica
+}
+
+func TestOnChange(t *testing.T) {
+	v := New[int]()
+
+	type event struct {
+		op    Operation
+		index int
+		value int
+	}
+	var events []event
+	v.OnChange(func(op Operation, index int, value int) {
+		events = append(events, event{op, index, value})
+	})
+
+	_ = v.PushBack(1)
+	_ = v.PushBack(2)
+	_ = v.Insert(0, 0)
+	_ = v.Set(1, 10)
+	_ = v.Erase(2)
+	_ = v.Resize(3, 7)
+	v.Clear()
+
+	expected := []event{
+		{OpPushBack, 0, 1},
+		{OpPushBack, 1, 2},
+		{OpInsert, 0, 0},
+		{OpSet, 1, 10},
+		{OpErase, 2, 2},
+		{OpResize, 3, 7},
+		{OpClear, 0, 0},
+	}
+	assert.Equal(t, expected, events)
+}
+
+func TestOnChangeCancel(t *testing.T) {
+	v := New[string]()
+
+	first, second := 0, 0
+	cancel := v.OnChange(func(Operation, int, string) { first++ })
+	v.OnChange(func(Operation, int, string) { second++ })
+
+	_ = v.PushBack("a")
+	cancel()
+	_ = v.PushBack("b")
+	cancel()
+
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 2, second)
+}
+
+func TestOnChangeReplay(t *testing.T) {
+	v := New[int](WithValues(5, 3, 8))
+	replica := mirror(v)
+
+	_ = v.PushBack(1)
+	_ = v.InsertSlice(1, []int{4, 4, 9})
+	_ = v.EraseUnordered(0)
+	_ = v.EraseRange(1, 3)
+	_, _ = v.PopFront()
+	_ = v.PopBack()
+	v.RemoveIf(func(x int) bool { return x == 4 })
+	_ = v.Append(New[int](WithValues(2, 2, 6, 2)))
+	v.DedupAdjacent(func(a, b int) bool { return a == b })
+	v.Unique(func(a, b int) bool { return a == b })
+	_ = v.Resize(6, -1)
+	_ = v.Resize(4, 0)
+
+	assert.Equal(t, v.Data(), *replica)
+
+	_ = v.Assign(1, 2, 3)
+	assert.Equal(t, v.Data(), *replica)
+
+	other := New[int](WithValues(9, 9))
+	otherReplica := mirror(other)
+	v.Swap(other)
+	assert.Equal(t, []int{9, 9}, *replica)
+	assert.Equal(t, []int{1, 2, 3}, *otherReplica)
+}
+
+func TestOnChangeNotCopied(t *testing.T) {
+	v := New[int]()
+	calls := 0
+	v.OnChange(func(Operation, int, int) { calls++ })
+
+	clone := v.Clone()
+	_ = clone.PushBack(1)
+	assert.Equal(t, 0, calls)
+}
+
+func TestOperationString(t *testing.T) {
+	assert.Equal(t, "PushBack", OpPushBack.String())
+	assert.Equal(t, "Clear", OpClear.String())
+	assert.Equal(t, "Unknown", Operation(100).String())
+}
@@ -0,0 +1,37 @@
+package vector
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ParallelMap is like Map but applies f concurrently using up to workers goroutines.
+// The order of the elements is preserved. A non-positive workers uses GOMAXPROCS
+func ParallelMap[T, U any](v *Vector[T], workers int, f func(T) U) *Vector[U] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	src := v.elements()
+	dst := make([]U, len(src))
+	chunk := (len(src) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for from := 0; from < len(src); from += chunk {
+		to := min(from+chunk, len(src))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := from; i < to; i++ {
+				dst[i] = f(src[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := New[U]()
+	result.data = dst
+	result.size = len(dst)
+	result.capacity = len(dst)
+	return result
+}
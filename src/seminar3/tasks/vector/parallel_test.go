@@ -0,0 +1,67 @@
+package vector
+
+import (
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap(t *testing.T) {
+	v := New[int]()
+	for i := 0; i < 1000; i++ {
+		v.PushBack(i)
+	}
+
+	for _, workers := range []int{-1, 0, 1, 3, 8, 2000} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			result := ParallelMap(v, workers, func(x int) int { return x * x })
+			assert.Equal(t, Map(v, func(x int) int { return x * x }).Data(), result.Data())
+		})
+	}
+}
+
+func TestParallelMapCallsOncePerElement(t *testing.T) {
+	v := New[int](WithFill(100, 1))
+
+	var calls atomic.Int64
+	result := ParallelMap(v, 4, func(x int) string {
+		calls.Add(1)
+		return strconv.Itoa(x)
+	})
+
+	assert.Equal(t, int64(100), calls.Load())
+	assert.Equal(t, 100, result.Size())
+}
+
+func TestParallelMapEmpty(t *testing.T) {
+	result := ParallelMap(New[int](), 4, strconv.Itoa)
+	assert.True(t, result.Empty())
+
+	result.PushBack("a")
+	assert.Equal(t, 1, result.Size())
+}
+
+func BenchmarkParallelMap(b *testing.B) {
+	v := New[int](WithFill(10000, 30))
+	fib := func(n int) int {
+		a, c := 0, 1
+		for i := 0; i < n*100; i++ {
+			a, c = c, a+c
+		}
+		return a
+	}
+
+	b.Run("Map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(v, fib)
+		}
+	})
+
+	b.Run("ParallelMap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ParallelMap(v, 0, fib)
+		}
+	})
+}
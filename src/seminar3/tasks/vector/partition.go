@@ -0,0 +1,34 @@
+package vector
+
+// Partition reorders the elements so that all elements satisfying pred come first
+// and returns the index of the first element of the second group.
+// The relative order inside the groups is not preserved
+func (v *Vector[T]) Partition(pred func(T) bool) int {
+	data := v.Data()
+	split := 0
+	for i := range data {
+		if pred(data[i]) {
+			data[split], data[i] = data[i], data[split]
+			split++
+		}
+	}
+	return split
+}
+
+// StablePartition is like Partition but keeps the relative order of elements in both groups
+// It uses a temporary buffer for the rejected elements
+func (v *Vector[T]) StablePartition(pred func(T) bool) int {
+	data := v.Data()
+	var rejected []T
+	split := 0
+	for _, value := range data {
+		if pred(value) {
+			data[split] = value
+			split++
+		} else {
+			rejected = append(rejected, value)
+		}
+	}
+	copy(data[split:], rejected)
+	return split
+}
@@ -0,0 +1,46 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	v := New[int](WithValues(1, 2, 3, 4, 5, 6, 7))
+	split := v.Partition(isEven)
+
+	assert.Equal(t, 3, split)
+	assert.ElementsMatch(t, []int{2, 4, 6}, v.Data()[:split])
+	assert.ElementsMatch(t, []int{1, 3, 5, 7}, v.Data()[split:])
+
+	assert.Equal(t, 0, New[int]().Partition(isEven))
+	assert.Equal(t, 0, New[int](WithValues(1, 3)).Partition(isEven))
+	assert.Equal(t, 2, New[int](WithValues(2, 4)).Partition(isEven))
+}
+
+func TestStablePartition(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+
+	v := New[int](WithValues(1, 2, 3, 4, 5, 6, 7))
+	split := v.StablePartition(isEven)
+
+	assert.Equal(t, 3, split)
+	assert.Equal(t, []int{2, 4, 6, 1, 3, 5, 7}, v.Data())
+}
+
+func TestPartitionQuickSelect(t *testing.T) {
+	v := New[int](WithValues(9, 4, 7, 1, 8, 2))
+
+	pivot := 5
+	split := v.Partition(func(x int) bool { return x < pivot })
+	for i, x := range v.Data() {
+		if i < split {
+			assert.Less(t, x, pivot)
+		} else {
+			assert.GreaterOrEqual(t, x, pivot)
+		}
+	}
+}
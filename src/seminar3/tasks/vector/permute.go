@@ -0,0 +1,40 @@
+package vector
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// Reverse reverses the order of the elements in place
+func (v *Vector[T]) Reverse() {
+	slices.Reverse(v.Data())
+}
+
+// Rotate rotates the elements left by k positions, so the element at index k becomes the first one
+// Negative k rotates to the right
+func (v *Vector[T]) Rotate(k int) {
+	if v.size == 0 {
+		return
+	}
+	k = ((k % v.size) + v.size) % v.size
+
+	data := v.Data()
+	slices.Reverse(data[:k])
+	slices.Reverse(data[k:])
+	slices.Reverse(data)
+}
+
+// Shuffle randomly permutes the elements using rng
+// If rng is nil the global random source is used
+func (v *Vector[T]) Shuffle(rng *rand.Rand) {
+	data := v.Data()
+	swap := func(i, j int) {
+		data[i], data[j] = data[j], data[i]
+	}
+
+	if rng == nil {
+		rand.Shuffle(len(data), swap)
+		return
+	}
+	rng.Shuffle(len(data), swap)
+}
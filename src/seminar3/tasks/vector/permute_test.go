@@ -0,0 +1,65 @@
+package vector
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4))
+	v.Reverse()
+	assert.Equal(t, []int{4, 3, 2, 1}, v.Data())
+
+	empty := New[int]()
+	empty.Reverse()
+	assert.True(t, empty.Empty())
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name     string
+		k        int
+		expected []int
+	}{
+		{"zero", 0, []int{1, 2, 3, 4, 5}},
+		{"left by two", 2, []int{3, 4, 5, 1, 2}},
+		{"full cycle", 5, []int{1, 2, 3, 4, 5}},
+		{"more than size", 7, []int{3, 4, 5, 1, 2}},
+		{"right by one", -1, []int{5, 1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New[int](WithValues(1, 2, 3, 4, 5))
+			v.Rotate(tt.k)
+			assert.Equal(t, tt.expected, v.Data())
+		})
+	}
+
+	t.Run("Empty vector", func(t *testing.T) {
+		v := New[int]()
+		v.Rotate(3)
+		assert.True(t, v.Empty())
+	})
+}
+
+func TestShuffle(t *testing.T) {
+	t.Run("Deterministic with seed", func(t *testing.T) {
+		a := New[int](WithValues(1, 2, 3, 4, 5, 6, 7, 8))
+		b := a.Clone()
+
+		a.Shuffle(rand.New(rand.NewPCG(1, 2)))
+		b.Shuffle(rand.New(rand.NewPCG(1, 2)))
+
+		assert.Equal(t, a.Data(), b.Data())
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, a.Data())
+	})
+
+	t.Run("Global source", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		v.Shuffle(nil)
+		assert.ElementsMatch(t, []int{1, 2, 3}, v.Data())
+	})
+}
@@ -0,0 +1,34 @@
+package vector
+
+import "sync"
+
+// VectorPool recycles cleared vectors together with their backing arrays
+// It is safe for concurrent use
+type VectorPool[T any] struct {
+	pool sync.Pool
+}
+
+// NewPool creates a pool that builds new vectors with the given options when it is empty
+func NewPool[T any](options ...Option[T]) *VectorPool[T] {
+	return &VectorPool[T]{
+		pool: sync.Pool{
+			New: func() any {
+				return New[T](options...)
+			},
+		},
+	}
+}
+
+// Get returns an empty vector, reusing a previously returned one when possible
+func (p *VectorPool[T]) Get() *Vector[T] {
+	return p.pool.Get().(*Vector[T])
+}
+
+// Put clears v and returns it to the pool; v must not be used afterwards
+func (p *VectorPool[T]) Put(v *Vector[T]) {
+	if v == nil {
+		return
+	}
+	v.Clear()
+	p.pool.Put(v)
+}
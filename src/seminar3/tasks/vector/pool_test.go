@@ -0,0 +1,72 @@
+package vector
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVectorPool(t *testing.T) {
+	pool := NewPool[int](WithCapacity[int](16))
+
+	v := pool.Get()
+	assert.True(t, v.Empty())
+	assert.Equal(t, 16, v.Capacity())
+
+	v.PushBack(1)
+	v.PushBack(2)
+	pool.Put(v)
+	assert.True(t, v.Empty(), "Put clears the vector")
+
+	reused := pool.Get()
+	assert.True(t, reused.Empty())
+	assert.GreaterOrEqual(t, reused.Capacity(), 16)
+
+	pool.Put(nil)
+}
+
+func TestVectorPoolConcurrent(t *testing.T) {
+	pool := NewPool[int]()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				v := pool.Get()
+				assert.True(t, v.Empty())
+				v.PushBack(i)
+				pool.Put(v)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkVectorPool(b *testing.B) {
+	const n = 64
+
+	b.Run("New", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			v := New[int]()
+			for j := 0; j < n; j++ {
+				v.PushBack(j)
+			}
+		}
+	})
+
+	b.Run("Pool", func(b *testing.B) {
+		b.ReportAllocs()
+		pool := NewPool[int]()
+		for i := 0; i < b.N; i++ {
+			v := pool.Get()
+			for j := 0; j < n; j++ {
+				v.PushBack(j)
+			}
+			pool.Put(v)
+		}
+	})
+}
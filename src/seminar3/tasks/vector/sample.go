@@ -0,0 +1,67 @@
+package vector
+
+import "math/rand/v2"
+
+// Sample returns a new vector with n elements of v chosen uniformly at random without replacement
+// n is clamped to [0, Size()]. If rng is nil the global random source is used
+func (v *Vector[T]) Sample(n int, rng *rand.Rand) *Vector[T] {
+	n = min(max(n, 0), v.size)
+	pool := make([]T, v.size)
+	copy(pool, v.elements())
+
+	// Partial Fisher-Yates: after step i the first i+1 elements are the sample
+	for i := range n {
+		j := i + intN(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return New[T](WithValues(pool[:n]...))
+}
+
+// ReservoirSampler keeps a uniform random sample of up to k elements from a stream of unknown length
+type ReservoirSampler[T any] struct {
+	reservoir []T
+	k         int
+	seen      int
+	rng       *rand.Rand
+}
+
+// NewReservoirSampler creates a sampler keeping k elements
+// If rng is nil the global random source is used
+func NewReservoirSampler[T any](k int, rng *rand.Rand) *ReservoirSampler[T] {
+	k = max(k, 0)
+	return &ReservoirSampler[T]{
+		reservoir: make([]T, 0, k),
+		k:         k,
+		rng:       rng,
+	}
+}
+
+// Add offers the next element of the stream to the sampler
+func (s *ReservoirSampler[T]) Add(value T) {
+	s.seen++
+	if len(s.reservoir) < s.k {
+		s.reservoir = append(s.reservoir, value)
+		return
+	}
+	if j := intN(s.rng, s.seen); j < s.k {
+		s.reservoir[j] = value
+	}
+}
+
+// Seen returns the number of elements offered so far
+func (s *ReservoirSampler[T]) Seen() int {
+	return s.seen
+}
+
+// Sample returns the current sample as a new vector
+func (s *ReservoirSampler[T]) Sample() *Vector[T] {
+	return New[T](WithValues(s.reservoir...))
+}
+
+// intN returns a random number in [0, n) from rng or from the global source if rng is nil
+func intN(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.IntN(n)
+	}
+	return rng.IntN(n)
+}
@@ -0,0 +1,86 @@
+package vector
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSample(t *testing.T) {
+	t.Run("Distinct elements from the source", func(t *testing.T) {
+		v := New[int](WithValues(0, 1, 2, 3, 4, 5, 6, 7, 8, 9))
+		sample := v.Sample(4, rand.New(rand.NewPCG(1, 1)))
+
+		assert.Equal(t, 4, sample.Size())
+		seen := map[int]bool{}
+		for _, x := range sample.Data() {
+			assert.True(t, ContainsComparable(v, x))
+			assert.False(t, seen[x])
+			seen[x] = true
+		}
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, v.Data(), "source is unchanged")
+	})
+
+	t.Run("Clamps n", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		assert.ElementsMatch(t, []int{1, 2, 3}, v.Sample(10, nil).Data())
+		assert.True(t, v.Sample(-1, nil).Empty())
+	})
+
+	t.Run("Roughly uniform", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(2, 2))
+		v := New[int](WithValues(0, 1, 2, 3, 4))
+		counts := make([]int, 5)
+		for range 5000 {
+			for _, x := range v.Sample(2, rng).Data() {
+				counts[x]++
+			}
+		}
+		for _, c := range counts {
+			assert.InDelta(t, 2000, c, 200)
+		}
+	})
+}
+
+func TestReservoirSampler(t *testing.T) {
+	t.Run("Short stream is kept whole", func(t *testing.T) {
+		s := NewReservoirSampler[string](5, nil)
+		s.Add("a")
+		s.Add("b")
+		assert.Equal(t, []string{"a", "b"}, s.Sample().Data())
+		assert.Equal(t, 2, s.Seen())
+	})
+
+	t.Run("Keeps k elements", func(t *testing.T) {
+		s := NewReservoirSampler[int](3, rand.New(rand.NewPCG(3, 3)))
+		for i := range 100 {
+			s.Add(i)
+		}
+		assert.Equal(t, 3, s.Sample().Size())
+		assert.Equal(t, 100, s.Seen())
+	})
+
+	t.Run("Roughly uniform", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(4, 4))
+		counts := make([]int, 10)
+		for range 5000 {
+			s := NewReservoirSampler[int](2, rng)
+			for i := range 10 {
+				s.Add(i)
+			}
+			for _, x := range s.Sample().Data() {
+				counts[x]++
+			}
+		}
+		for _, c := range counts {
+			assert.InDelta(t, 1000, c, 150)
+		}
+	})
+
+	t.Run("Zero size", func(t *testing.T) {
+		s := NewReservoirSampler[int](0, nil)
+		s.Add(1)
+		assert.True(t, s.Sample().Empty())
+	})
+}
@@ -0,0 +1,40 @@
+package vector
+
+import "slices"
+
+// IndexOf returns the index of the first element equal to value according to eq, or -1
+func (v *Vector[T]) IndexOf(value T, eq func(a, b T) bool) int {
+	return slices.IndexFunc(v.elements(), func(x T) bool {
+		return eq(x, value)
+	})
+}
+
+// LastIndexOf returns the index of the last element equal to value according to eq, or -1
+func (v *Vector[T]) LastIndexOf(value T, eq func(a, b T) bool) int {
+	for i := v.size - 1; i >= 0; i-- {
+		if eq(v.data[i], value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether the vector holds an element equal to value according to eq
+func (v *Vector[T]) Contains(value T, eq func(a, b T) bool) bool {
+	return v.IndexOf(value, eq) >= 0
+}
+
+// ContainsComparable reports whether the vector holds value
+func ContainsComparable[T comparable](v *Vector[T], value T) bool {
+	return slices.Contains(v.elements(), value)
+}
+
+// IndexOfComparable returns the index of the first occurrence of value, or -1
+func IndexOfComparable[T comparable](v *Vector[T], value T) int {
+	return slices.Index(v.elements(), value)
+}
+
+// LastIndexOfComparable returns the index of the last occurrence of value, or -1
+func LastIndexOfComparable[T comparable](v *Vector[T], value T) int {
+	return v.LastIndexOf(value, func(a, b T) bool { return a == b })
+}
@@ -0,0 +1,44 @@
+package vector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexOf(t *testing.T) {
+	v := New[string](WithValues("a", "B", "c", "b"))
+
+	assert.Equal(t, 1, v.IndexOf("b", strings.EqualFold))
+	assert.Equal(t, 3, v.LastIndexOf("b", strings.EqualFold))
+	assert.Equal(t, -1, v.IndexOf("z", strings.EqualFold))
+	assert.Equal(t, -1, v.LastIndexOf("z", strings.EqualFold))
+	assert.Equal(t, -1, New[string]().IndexOf("a", strings.EqualFold))
+}
+
+func TestContains(t *testing.T) {
+	v := New[string](WithValues("Go", "Rust"))
+
+	assert.True(t, v.Contains("go", strings.EqualFold))
+	assert.False(t, v.Contains("C", strings.EqualFold))
+}
+
+func TestComparableHelpers(t *testing.T) {
+	v := New[int](WithValues(3, 1, 4, 1, 5))
+
+	assert.True(t, ContainsComparable(v, 4))
+	assert.False(t, ContainsComparable(v, 2))
+	assert.Equal(t, 1, IndexOfComparable(v, 1))
+	assert.Equal(t, 3, LastIndexOfComparable(v, 1))
+	assert.Equal(t, -1, IndexOfComparable(v, 9))
+	assert.Equal(t, -1, LastIndexOfComparable(v, 9))
+}
+
+func TestSearchIgnoresSpareCapacity(t *testing.T) {
+	v := New[int](WithCapacity[int](4))
+	v.PushBack(1)
+
+	assert.False(t, ContainsComparable(v, 0))
+	assert.Equal(t, -1, LastIndexOfComparable(v, 0))
+}
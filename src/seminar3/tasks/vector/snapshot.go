@@ -0,0 +1,70 @@
+package vector
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Snapshot is a read-only view of a vector at the moment it was taken.
+// It shares the backing array with the vector until the vector is written to,
+// at which point the vector copies its data (copy-on-write), so taking a snapshot is O(1)
+type Snapshot[T any] struct {
+	data []T
+}
+
+// Snapshot returns a read-only view that stays unchanged when the vector is mutated later
+func (v *Vector[T]) Snapshot() Snapshot[T] {
+	v.shared = true
+	return Snapshot[T]{data: v.data[:v.size:v.size]}
+}
+
+// Size returns the number of elements in the snapshot
+func (s Snapshot[T]) Size() int {
+	return len(s.data)
+}
+
+// Empty returns true if the snapshot is empty
+func (s Snapshot[T]) Empty() bool {
+	return len(s.data) == 0
+}
+
+// At returns the element at the specified index with bounds checking
+func (s Snapshot[T]) At(index int) (T, error) {
+	if index < 0 || index >= len(s.data) {
+		var zero T
+		return zero, fmt.Errorf("%w: index %d, size %d", ErrIndexOutOfRange, index, len(s.data))
+	}
+	return s.data[index], nil
+}
+
+// All returns an iterator over index-value pairs of the snapshot
+func (s Snapshot[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, value := range s.data {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the snapshot
+func (s Snapshot[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range s.data {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ToVector copies the snapshot into a new mutable vector
+func (s Snapshot[T]) ToVector() *Vector[T] {
+	return New[T](FromSlice(s.data))
+}
+
+// String returns a string representation of the snapshot as Vector[...]
+func (s Snapshot[T]) String() string {
+	return s.ToVector().String()
+}
@@ -0,0 +1,81 @@
+package vector
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+	snap := v.Snapshot()
+
+	assert.Equal(t, 3, snap.Size())
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(snap.Values()))
+
+	val, err := snap.At(2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, val)
+
+	_, err = snap.At(3)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	assert.Equal(t, "Vector[1 2 3]", snap.String())
+}
+
+func TestSnapshotSurvivesMutations(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(v *Vector[int])
+	}{
+		{"push back", func(v *Vector[int]) { v.PushBack(4) }},
+		{"pop back", func(v *Vector[int]) { _ = v.PopBack() }},
+		{"insert", func(v *Vector[int]) { _ = v.Insert(0, 0) }},
+		{"erase", func(v *Vector[int]) { _ = v.Erase(0) }},
+		{"erase unordered", func(v *Vector[int]) { _ = v.EraseUnordered(0) }},
+		{"clear", func(v *Vector[int]) { v.Clear() }},
+		{"assign", func(v *Vector[int]) { v.Assign(7, 8, 9) }},
+		{"set", func(v *Vector[int]) { _ = v.Set(0, 100) }},
+		{"write through data", func(v *Vector[int]) { v.Data()[0] = 100 }},
+		{"sort", func(v *Vector[int]) { v.Sort(func(a, b int) bool { return a > b }) }},
+		{"reverse", func(v *Vector[int]) { v.Reverse() }},
+		{"remove if", func(v *Vector[int]) { v.RemoveIf(func(x int) bool { return x == 1 }) }},
+		{"erase range", func(v *Vector[int]) { _ = v.EraseRange(0, 2) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New[int](WithCapacity[int](8))
+			v.Assign(1, 2, 3)
+			snap := v.Snapshot()
+
+			tt.modify(v)
+
+			assert.Equal(t, []int{1, 2, 3}, slices.Collect(snap.Values()))
+		})
+	}
+}
+
+func TestSnapshotIsCheap(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+	data := v.data
+
+	snap := v.Snapshot()
+	assert.Same(t, &data[0], &snap.data[0], "snapshot shares the backing array")
+
+	_, _ = v.At(0)
+	assert.Equal(t, 3, v.Size())
+	assert.Same(t, &data[0], &v.data[0], "reads do not copy")
+
+	v.Data()[0] = 10
+	assert.NotSame(t, &data[0], &v.data[0], "first write copies")
+}
+
+func TestSnapshotToVector(t *testing.T) {
+	v := New[string](WithValues("a", "b"))
+	copied := v.Snapshot().ToVector()
+	copied.PushBack("c")
+
+	assert.Equal(t, []string{"a", "b"}, v.Data())
+	assert.Equal(t, []string{"a", "b", "c"}, copied.Data())
+}
@@ -0,0 +1,74 @@
+package vector
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// Sort sorts the vector in place using the less function
+// The sort is not guaranteed to be stable
+func (v *Vector[T]) Sort(less func(a, b T) bool) {
+	data := v.Data()
+	sort.Slice(data, func(i, j int) bool {
+		return less(data[i], data[j])
+	})
+}
+
+// StableSort sorts the vector in place keeping the original order of equal elements
+func (v *Vector[T]) StableSort(less func(a, b T) bool) {
+	data := v.Data()
+	sort.SliceStable(data, func(i, j int) bool {
+		return less(data[i], data[j])
+	})
+}
+
+// SortOrdered sorts a vector of ordered values in ascending order
+func SortOrdered[T cmp.Ordered](v *Vector[T]) {
+	slices.Sort(v.Data())
+}
+
+// BinarySearch searches a sorted vector for value using cmp
+// It returns the position where value is found or would be inserted, and whether it was found
+func (v *Vector[T]) BinarySearch(value T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(v.elements(), value, cmp)
+}
+
+// InsertSorted inserts value into a sorted vector keeping it sorted and returns its index
+// Equal elements keep their relative order: value is placed after them
+// If the vector cannot grow, the error of Insert is returned and the vector is left unchanged
+func (v *Vector[T]) InsertSorted(value T, cmp func(a, b T) int) (int, error) {
+	data := v.elements()
+	index := sort.Search(len(data), func(i int) bool {
+		return cmp(data[i], value) > 0
+	})
+	if err := v.Insert(index, value); err != nil {
+		return 0, err
+	}
+	return index, nil
+}
+
+// SortInterface returns an adapter that lets the vector be used with sort.Sort, sort.Stable,
+// sort.IsSorted and similar standard library functions, ordering elements by less
+func (v *Vector[T]) SortInterface(less func(a, b T) bool) sort.Interface {
+	return &sortAdapter[T]{vec: v, less: less}
+}
+
+// sortAdapter implements sort.Interface on top of a vector
+type sortAdapter[T any] struct {
+	vec  *Vector[T]
+	less func(a, b T) bool
+}
+
+func (s *sortAdapter[T]) Len() int {
+	return s.vec.size
+}
+
+func (s *sortAdapter[T]) Less(i, j int) bool {
+	return s.less(s.vec.data[i], s.vec.data[j])
+}
+
+func (s *sortAdapter[T]) Swap(i, j int) {
+	s.vec.detach()
+	s.vec.data[i], s.vec.data[j] = s.vec.data[j], s.vec.data[i]
+}
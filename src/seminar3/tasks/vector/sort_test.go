@@ -0,0 +1,160 @@
+package vector
+
+import (
+	"cmp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	t.Run("Ascending", func(t *testing.T) {
+		v := New[int](WithValues(5, 2, 4, 1, 3))
+		v.Sort(func(a, b int) bool { return a < b })
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+	})
+
+	t.Run("Descending", func(t *testing.T) {
+		v := New[int](WithValues(5, 2, 4, 1, 3))
+		v.Sort(func(a, b int) bool { return a > b })
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, v.Data())
+	})
+
+	t.Run("Empty vector", func(t *testing.T) {
+		v := New[int]()
+		v.Sort(func(a, b int) bool { return a < b })
+		assert.True(t, v.Empty())
+	})
+
+	t.Run("Ignores spare capacity", func(t *testing.T) {
+		v := New[int](WithCapacity[int](10))
+		v.PushBack(3)
+		v.PushBack(-1)
+		v.Sort(func(a, b int) bool { return a < b })
+		assert.Equal(t, []int{-1, 3}, v.Data())
+	})
+}
+
+func TestStableSort(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	v := New[Person](WithValues(
+		Person{"Alice", 30},
+		Person{"Bob", 25},
+		Person{"Carol", 30},
+		Person{"Dave", 25},
+	))
+	v.StableSort(func(a, b Person) bool { return a.Age < b.Age })
+
+	expected := []Person{{"Bob", 25}, {"Dave", 25}, {"Alice", 30}, {"Carol", 30}}
+	assert.Equal(t, expected, v.Data())
+}
+
+func TestSortOrdered(t *testing.T) {
+	v := New[string](WithValues("pear", "apple", "fig"))
+	SortOrdered(v)
+	assert.Equal(t, []string{"apple", "fig", "pear"}, v.Data())
+}
+
+func TestBinarySearch(t *testing.T) {
+	v := New[int](WithValues(10, 20, 30, 40, 50))
+
+	index, found := v.BinarySearch(30, cmp.Compare[int])
+	assert.True(t, found)
+	assert.Equal(t, 2, index)
+
+	index, found = v.BinarySearch(35, cmp.Compare[int])
+	assert.False(t, found)
+	assert.Equal(t, 3, index)
+
+	index, found = v.BinarySearch(5, cmp.Compare[int])
+	assert.False(t, found)
+	assert.Equal(t, 0, index)
+
+	index, found = New[int]().BinarySearch(1, cmp.Compare[int])
+	assert.False(t, found)
+	assert.Equal(t, 0, index)
+}
+
+func TestInsertSorted(t *testing.T) {
+	t.Run("Keeps order", func(t *testing.T) {
+		v := New[int]()
+		for _, x := range []int{5, 1, 4, 2, 3} {
+			_, err := v.InsertSorted(x, cmp.Compare[int])
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+	})
+
+	t.Run("Returns index", func(t *testing.T) {
+		v := New[int](WithValues(1, 3, 5))
+		for _, tc := range []struct{ value, index int }{{0, 0}, {2, 2}, {9, 5}} {
+			index, err := v.InsertSorted(tc.value, cmp.Compare[int])
+			assert.NoError(t, err)
+			assert.Equal(t, tc.index, index)
+		}
+	})
+
+	t.Run("Full vector", func(t *testing.T) {
+		v := New[int](WithMaxCapacity[int](2), WithValues(1, 3))
+		_, err := v.InsertSorted(2, cmp.Compare[int])
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+		assert.Equal(t, []int{1, 3}, v.Data())
+	})
+
+	t.Run("Equal elements stay stable", func(t *testing.T) {
+		type item struct {
+			key, id int
+		}
+		byKey := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+
+		v := New[item](WithValues(item{1, 0}, item{2, 1}))
+		_, err := v.InsertSorted(item{1, 2}, byKey)
+		assert.NoError(t, err)
+		assert.Equal(t, []item{{1, 0}, {1, 2}, {2, 1}}, v.Data())
+	})
+}
+
+func TestSortInterface(t *testing.T) {
+	t.Run("sort.Sort", func(t *testing.T) {
+		v := New[int](WithValues(3, 1, 2))
+		sort.Sort(v.SortInterface(func(a, b int) bool { return a < b }))
+		assert.Equal(t, []int{1, 2, 3}, v.Data())
+	})
+
+	t.Run("sort.Stable and sort.Reverse", func(t *testing.T) {
+		type item struct {
+			key int
+			tag string
+		}
+		v := New[item](WithValues(item{1, "a"}, item{2, "b"}, item{1, "c"}))
+		sort.Stable(sort.Reverse(v.SortInterface(func(a, b item) bool { return a.key < b.key })))
+		assert.Equal(t, []item{{2, "b"}, {1, "a"}, {1, "c"}}, v.Data())
+	})
+
+	t.Run("sort.IsSorted", func(t *testing.T) {
+		less := func(a, b string) bool { return a < b }
+		assert.True(t, sort.IsSorted(New[string](WithValues("a", "b")).SortInterface(less)))
+		assert.False(t, sort.IsSorted(New[string](WithValues("b", "a")).SortInterface(less)))
+	})
+
+	t.Run("sort.Search", func(t *testing.T) {
+		v := New[int](WithValues(10, 20, 30))
+		index := sort.Search(v.Size(), func(i int) bool { return v.MustAt(i) >= 25 })
+		assert.Equal(t, 2, index)
+	})
+
+	t.Run("Snapshot is preserved", func(t *testing.T) {
+		v := New[int](WithValues(2, 1))
+		adapter := v.SortInterface(func(a, b int) bool { return a < b })
+		snap := v.Snapshot()
+
+		sort.Sort(adapter)
+		assert.Equal(t, []int{1, 2}, v.Data())
+		assert.Equal(t, "Vector[2 1]", snap.String())
+	})
+}
@@ -0,0 +1,59 @@
+package vector
+
+// Splice moves all elements of other into the vector starting at position at, leaving other empty.
+// When other has the larger backing array and it fits the result, that array is taken over
+// instead of allocating, and other receives the old array of the vector
+func (v *Vector[T]) Splice(at int, other *Vector[T]) error {
+	if err := v.checkIndex(at, v.size+1); err != nil {
+		return err
+	}
+	if other == v || other.size == 0 {
+		return nil
+	}
+
+	moved := other.size
+	total := v.size + moved
+	if !v.canAdopt(other, total) {
+		if err := v.InsertSlice(at, other.elements()); err != nil {
+			return err
+		}
+		other.Clear()
+		return nil
+	}
+
+	// Lay out the result inside the array of other: its elements go to the middle
+	buf := other.data
+	copy(buf[at:at+moved], buf[:moved])
+	copy(buf[:at], v.data[:at])
+	copy(buf[at+moved:total], v.data[at:v.size])
+
+	oldData, oldCapacity := v.data, v.capacity
+	clear(oldData[:v.size])
+
+	v.data, v.capacity, v.size = buf, other.capacity, total
+	other.data, other.capacity, other.size = oldData, oldCapacity, 0
+	v.modCount++
+	other.modCount++
+	other.shrinkIfNeeded()
+
+	for i := at; i < at+moved; i++ {
+		v.notify(OpInsert, i, v.data[i])
+	}
+	var zero T
+	other.notify(OpClear, 0, zero)
+	return nil
+}
+
+// canAdopt reports whether the backing array of other can be taken over to hold total elements
+func (v *Vector[T]) canAdopt(other *Vector[T], total int) bool {
+	switch {
+	case other.capacity <= v.capacity || other.capacity < total:
+		return false
+	case v.maxCapacity > 0 && other.capacity > v.maxCapacity:
+		return false
+	case v.shared || other.shared:
+		// Snapshots pin the arrays to their current owners
+		return false
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		name     string
+		at       int
+		expected []int
+	}{
+		{"front", 0, []int{7, 8, 1, 2, 3}},
+		{"middle", 1, []int{1, 7, 8, 2, 3}},
+		{"back", 3, []int{1, 2, 3, 7, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New[int](WithValues(1, 2, 3))
+			other := New[int](WithValues(7, 8))
+
+			err := v.Splice(tt.at, other)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, v.Data())
+			assert.True(t, other.Empty())
+		})
+	}
+}
+
+func TestSpliceAdoptsLargerArray(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+	other := New[int](WithCapacity[int](16))
+	_ = other.Assign(7, 8)
+	otherArray := &other.data[0]
+
+	err := v.Splice(1, other)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 7, 8, 2, 3}, v.Data())
+	assert.Equal(t, 16, v.Capacity())
+	assert.Same(t, otherArray, &v.data[0])
+
+	assert.True(t, other.Empty())
+	assert.Equal(t, 3, other.Capacity(), "other gets the old array")
+	assert.Equal(t, []int{0, 0, 0}, other.data[:3], "old array is cleared")
+}
+
+func TestSpliceKeepsSnapshotsIntact(t *testing.T) {
+	v := New[int](WithValues(1))
+	other := New[int](WithCapacity[int](8))
+	_ = other.Assign(2, 3)
+	snap := other.Snapshot()
+
+	assert.NoError(t, v.Splice(0, other))
+	assert.Equal(t, []int{2, 3, 1}, v.Data())
+	assert.Equal(t, "Vector[2 3]", snap.String())
+}
+
+func TestSpliceErrors(t *testing.T) {
+	v := New[int](WithValues(1, 2))
+	other := New[int](WithValues(3))
+
+	assert.ErrorIs(t, v.Splice(5, other), ErrIndexOutOfRange)
+	assert.Equal(t, 1, other.Size(), "other is untouched on error")
+
+	bounded := New[int](WithMaxCapacity[int](2), WithValues(1, 2))
+	assert.ErrorIs(t, bounded.Splice(0, other), ErrCapacityExceeded)
+	assert.Equal(t, 1, other.Size())
+
+	assert.NoError(t, v.Splice(0, v))
+	assert.Equal(t, []int{1, 2}, v.Data())
+}
+
+func TestSpliceNotifies(t *testing.T) {
+	v := New[int](WithValues(1, 2))
+	other := New[int](WithCapacity[int](8))
+	_ = other.Assign(5, 6)
+
+	replica := mirror(v)
+	otherReplica := mirror(other)
+
+	assert.NoError(t, v.Splice(1, other))
+	assert.Equal(t, v.Data(), *replica)
+	assert.Empty(t, *otherReplica)
+}
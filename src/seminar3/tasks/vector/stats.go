@@ -0,0 +1,39 @@
+package vector
+
+import "unsafe"
+
+// Stats describes the memory usage of a vector
+type Stats struct {
+	// Size is the number of elements
+	Size int
+	// Capacity is the number of elements the backing array can hold
+	Capacity int
+	// Bytes is the size of the backing array in bytes
+	Bytes uintptr
+	// Reallocations is the number of times the elements moved to a new backing array since creation
+	Reallocations int
+	// ElementsCopied is the total number of elements copied by those moves
+	ElementsCopied int
+}
+
+// Stats returns the current memory statistics of the vector
+func (v *Vector[T]) Stats() Stats {
+	var zero T
+	return Stats{
+		Size:           v.size,
+		Capacity:       v.capacity,
+		Bytes:          uintptr(v.capacity) * unsafe.Sizeof(zero),
+		Reallocations:  v.reallocations,
+		ElementsCopied: v.elementsCopied,
+	}
+}
+
+// recordRealloc accounts for moving the current elements to a new backing array
+// The first allocation of an empty vector is not a move and is not counted
+func (v *Vector[T]) recordRealloc() {
+	if v.capacity == 0 {
+		return
+	}
+	v.reallocations++
+	v.elementsCopied += v.size
+}
@@ -0,0 +1,61 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	t.Run("Doubling growth", func(t *testing.T) {
+		v := New[int64]()
+		for i := range 9 {
+			v.PushBack(int64(i))
+		}
+
+		// Capacity goes 1, 2, 4, 8, 16 copying 1 + 2 + 4 + 8 elements
+		assert.Equal(t, Stats{
+			Size:           9,
+			Capacity:       16,
+			Bytes:          16 * 8,
+			Reallocations:  4,
+			ElementsCopied: 15,
+		}, v.Stats())
+	})
+
+	t.Run("Options are not counted", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3, 4, 5))
+		stats := v.Stats()
+		assert.Equal(t, 5, stats.Size)
+		assert.Zero(t, stats.Reallocations)
+		assert.Zero(t, stats.ElementsCopied)
+	})
+
+	t.Run("Reserve up front avoids reallocations", func(t *testing.T) {
+		v := New[int](WithCapacity[int](100))
+		for i := range 100 {
+			v.PushBack(i)
+		}
+		assert.Zero(t, v.Stats().Reallocations)
+	})
+
+	t.Run("Growth factor", func(t *testing.T) {
+		doubling := New[int]()
+		slow := New[int](WithGrowthFactor[int](1.25))
+		for i := range 1000 {
+			doubling.PushBack(i)
+			slow.PushBack(i)
+		}
+		assert.Greater(t, slow.Stats().Reallocations, doubling.Stats().Reallocations)
+		assert.Greater(t, slow.Stats().ElementsCopied, doubling.Stats().ElementsCopied)
+	})
+
+	t.Run("Clone starts fresh", func(t *testing.T) {
+		v := New[int]()
+		for i := range 10 {
+			v.PushBack(i)
+		}
+		assert.Positive(t, v.Stats().Reallocations)
+		assert.Zero(t, v.Clone().Stats().Reallocations)
+	})
+}
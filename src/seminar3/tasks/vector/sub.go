@@ -0,0 +1,19 @@
+package vector
+
+// SubVector returns a new vector holding a copy of the elements in [from, to)
+func (v *Vector[T]) SubVector(from, to int) (*Vector[T], error) {
+	if err := v.checkRange(from, to); err != nil {
+		return nil, err
+	}
+	return New[T](WithValues(v.data[from:to]...)), nil
+}
+
+// SubSlice returns a read-only view of the elements in [from, to)
+// Like Snapshot, the view shares the backing array and stays unchanged when the vector is modified later
+func (v *Vector[T]) SubSlice(from, to int) (Snapshot[T], error) {
+	if err := v.checkRange(from, to); err != nil {
+		return Snapshot[T]{}, err
+	}
+	v.shared = true
+	return Snapshot[T]{data: v.data[from:to:to]}, nil
+}
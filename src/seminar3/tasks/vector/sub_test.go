@@ -0,0 +1,61 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubVector(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5))
+
+	t.Run("Copies the range", func(t *testing.T) {
+		sub, err := v.SubVector(1, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 3, 4}, sub.Data())
+
+		sub.Set(0, 20)
+		sub.PushBack(6)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+	})
+
+	t.Run("Empty range", func(t *testing.T) {
+		sub, err := v.SubVector(2, 2)
+		assert.NoError(t, err)
+		assert.True(t, sub.Empty())
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		for _, r := range [][2]int{{-1, 2}, {0, 6}, {3, 2}} {
+			sub, err := v.SubVector(r[0], r[1])
+			assert.ErrorIs(t, err, ErrIndexOutOfRange)
+			assert.Nil(t, sub)
+		}
+	})
+}
+
+func TestSubSlice(t *testing.T) {
+	t.Run("View of the range", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3, 4, 5))
+		view, err := v.SubSlice(1, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, view.Size())
+		assert.Equal(t, "Vector[2 3]", view.String())
+	})
+
+	t.Run("Unaffected by later writes", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3, 4, 5))
+		view, _ := v.SubSlice(0, 2)
+
+		v.Set(0, 100)
+		v.PushBack(6)
+		assert.Equal(t, []int{1, 2}, view.ToVector().Data())
+		assert.Equal(t, []int{100, 2, 3, 4, 5, 6}, v.Data())
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		_, err := v.SubSlice(2, 4)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	})
+}
@@ -1,7 +1,18 @@
 package vector
 
 import (
-	"github.com/samber/lo"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	// ErrIndexOutOfRange is returned when an index is outside of the vector bounds
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrEmptyVector is returned when an operation requires at least one element
+	ErrEmptyVector = errors.New("vector is empty")
+	// ErrCapacityExceeded is returned when an operation would grow the vector past its maximum capacity
+	ErrCapacityExceeded = errors.New("capacity exceeded")
 )
 
 // Option is a functional option type for configuring vector creation
@@ -12,31 +23,75 @@ type Vector[T any] struct {
 	data     []T
 	size     int
 	capacity int
+
+	// modCount is incremented on every structural change to detect stale iterators
+	modCount int
+
+	// shared is set while a snapshot references data; the next in-place write copies it first
+	shared bool
+
+	// maxCapacity limits growth when positive, see WithMaxCapacity
+	maxCapacity int
+	// growthFunc overrides the default doubling growth strategy
+	growthFunc func(capacity int) int
+	// allocator provides backing arrays instead of make, see WithAllocator
+	allocator func(n int) []T
+	// shrinkFraction enables automatic shrinking when size/capacity drops below it
+	shrinkFraction float64
+	// observers are notified about pushes, inserts, erases and resizes, see OnChange
+	observers    []observer[T]
+	nextObserver int
+	// negativeIndex lets At and Set count negative indices from the end
+	negativeIndex bool
+	// stringer renders elements for String and the %v and %s verbs
+	stringer func(T) string
+	// stringLimit caps the number of elements printed by String and the %v and %s verbs when positive
+	stringLimit int
+
+	// reallocations and elementsCopied count backing array moves, see Stats
+	reallocations  int
+	elementsCopied int
 }
 
 // WithCapacity returns an option to set initial capacity
 func WithCapacity[T any](capacity int) Option[T] {
-	return func(v *Vector[T]) {}
+	return func(v *Vector[T]) {
+		v.data = make([]T, max(capacity, 0))
+		v.size = 0
+		v.capacity = len(v.data)
+	}
 }
 
 // WithValues returns an option to initialize with values
 func WithValues[T any](values ...T) Option[T] {
-	return func(v *Vector[T]) {}
+	return func(v *Vector[T]) {
+		v.data = make([]T, len(values))
+		copy(v.data, values)
+		v.size = len(values)
+		v.capacity = len(values)
+	}
 }
 
 // WithSize returns an option to set initial size with default value
 func WithSize[T any](size int, defaultValue T) Option[T] {
-	return func(v *Vector[T]) {}
+	return WithFill(size, defaultValue)
 }
 
 // WithFill returns an option to fill the vector with n copies of a value
 func WithFill[T any](count int, value T) Option[T] {
-	return func(v *Vector[T]) {}
+	return func(v *Vector[T]) {
+		v.data = make([]T, max(count, 0))
+		for i := range v.data {
+			v.data[i] = value
+		}
+		v.size = len(v.data)
+		v.capacity = len(v.data)
+	}
 }
 
 // FromSlice returns an option to initialize from an existing slice
 func FromSlice[T any](slice []T) Option[T] {
-	return func(v *Vector[T]) {}
+	return WithValues(slice...)
 }
 
 // New creates a new vector with the given options
@@ -52,6 +107,13 @@ func New[T any](options ...Option[T]) *Vector[T] {
 		option(v)
 	}
 
+	if v.maxCapacity > 0 && v.capacity > v.maxCapacity {
+		v.truncate(min(v.size, v.maxCapacity))
+		v.reserve(v.maxCapacity)
+	}
+	// Allocations made while applying the options are not part of the vector's history
+	v.reallocations, v.elementsCopied = 0, 0
+
 	return v
 }
 
@@ -73,71 +135,276 @@ func NewFloat64(options ...Option[float64]) *Vector[float64] {
 
 // Size returns the number of elements in the vector
 func (v *Vector[T]) Size() int {
-	return 0
+	return v.size
 }
 
 // Capacity returns the capacity of the vector
 func (v *Vector[T]) Capacity() int {
-	return 0
+	return v.capacity
 }
 
 // Empty returns true if the vector is empty
 func (v *Vector[T]) Empty() bool {
-	return false
+	return v.size == 0
 }
 
 // At returns the element at the specified index with bounds checking
 func (v *Vector[T]) At(index int) (T, error) {
-	return lo.FromPtr(new(T)), nil
+	if v.negativeIndex {
+		index = v.wrapIndex(index)
+	}
+	if err := v.checkIndex(index, v.size); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.data[index], nil
+}
+
+// Set replaces the element at the specified index with bounds checking
+func (v *Vector[T]) Set(index int, value T) error {
+	if v.negativeIndex {
+		index = v.wrapIndex(index)
+	}
+	if err := v.checkIndex(index, v.size); err != nil {
+		return err
+	}
+	v.detach()
+	v.data[index] = value
+	v.notify(OpSet, index, value)
+	return nil
 }
 
 // Front returns the first element
 func (v *Vector[T]) Front() (T, error) {
-	return lo.FromPtr(new(T)), nil
+	if v.size == 0 {
+		var zero T
+		return zero, ErrEmptyVector
+	}
+	return v.data[0], nil
 }
 
 // Back returns the last element
 func (v *Vector[T]) Back() (T, error) {
-	return lo.FromPtr(new(T)), nil
+	if v.size == 0 {
+		var zero T
+		return zero, ErrEmptyVector
+	}
+	return v.data[v.size-1], nil
 }
 
 // Data returns the underlying slice
 func (v *Vector[T]) Data() []T {
-	return []T{}
+	v.detach()
+	return v.elements()
 }
 
 // PushBack adds an element to the end of the vector
-func (v *Vector[T]) PushBack(value T) {}
+func (v *Vector[T]) PushBack(value T) error {
+	if err := v.grow(v.size + 1); err != nil {
+		return err
+	}
+	v.data[v.size] = value
+	v.size++
+	v.modCount++
+	v.notify(OpPushBack, v.size-1, value)
+	return nil
+}
 
 // PopBack removes the last element from the vector
 func (v *Vector[T]) PopBack() error {
-	return nil
+	_, err := v.PopBackValue()
+	return err
+}
+
+// PopBackValue removes the last element and returns it
+func (v *Vector[T]) PopBackValue() (T, error) {
+	value, err := v.Back()
+	if err != nil {
+		return value, err
+	}
+	v.truncate(v.size - 1)
+	v.notify(OpErase, v.size, value)
+	return value, nil
+}
+
+// PopFront removes the first element and returns it
+// Unlike PopBack it shifts all remaining elements, so it takes O(n)
+func (v *Vector[T]) PopFront() (T, error) {
+	value, err := v.Front()
+	if err != nil {
+		return value, err
+	}
+	_ = v.Erase(0)
+	return value, nil
 }
 
 // Insert inserts an element at the specified position
 func (v *Vector[T]) Insert(index int, value T) error {
+	if err := v.checkIndex(index, v.size+1); err != nil {
+		return err
+	}
+	if err := v.grow(v.size + 1); err != nil {
+		return err
+	}
+	v.detach()
+	copy(v.data[index+1:v.size+1], v.data[index:v.size])
+	v.data[index] = value
+	v.size++
+	v.modCount++
+	v.notify(OpInsert, index, value)
 	return nil
 }
 
 // Erase removes the element at the specified position
 func (v *Vector[T]) Erase(index int) error {
+	if err := v.checkIndex(index, v.size); err != nil {
+		return err
+	}
+	v.detach()
+	value := v.data[index]
+	copy(v.data[index:v.size-1], v.data[index+1:v.size])
+	v.truncate(v.size - 1)
+	v.notify(OpErase, index, value)
+	return nil
+}
+
+// EraseUnordered removes the element at the specified position in O(1)
+// by moving the last element into its place, so the order is not preserved
+func (v *Vector[T]) EraseUnordered(index int) error {
+	if err := v.checkIndex(index, v.size); err != nil {
+		return err
+	}
+	v.detach()
+	last, value := v.size-1, v.data[index]
+	v.data[index] = v.data[last]
+	v.truncate(last)
+
+	// Report the move as overwriting the erased slot followed by dropping the last one
+	if index != last {
+		v.notify(OpSet, index, v.data[index])
+		value = v.data[index]
+	}
+	v.notify(OpErase, last, value)
+	return nil
+}
+
+// InsertSlice inserts all values starting at the specified position
+func (v *Vector[T]) InsertSlice(index int, values []T) error {
+	if err := v.checkIndex(index, v.size+1); err != nil {
+		return err
+	}
+	if err := v.grow(v.size + len(values)); err != nil {
+		return err
+	}
+	v.detach()
+	copy(v.data[index+len(values):v.size+len(values)], v.data[index:v.size])
+	copy(v.data[index:], values)
+	v.size += len(values)
+	v.modCount++
+	for i := index; i < index+len(values); i++ {
+		v.notify(OpInsert, i, v.data[i])
+	}
+	return nil
+}
+
+// InsertVector inserts all elements of other starting at the specified position
+func (v *Vector[T]) InsertVector(index int, other *Vector[T]) error {
+	return v.InsertSlice(index, other.elements())
+}
+
+// EraseRange removes the elements in the half-open range [from, to)
+func (v *Vector[T]) EraseRange(from, to int) error {
+	if err := v.checkRange(from, to); err != nil {
+		return err
+	}
+	v.detach()
+	var removed []T
+	if len(v.observers) > 0 {
+		removed = slices.Clone(v.data[from:to])
+	}
+	copy(v.data[from:], v.data[to:v.size])
+	v.truncate(v.size - (to - from))
+	for _, value := range removed {
+		v.notify(OpErase, from, value)
+	}
 	return nil
 }
 
 // Clear removes all elements from the vector
-func (v *Vector[T]) Clear() {}
+func (v *Vector[T]) Clear() {
+	v.truncate(0)
+	var zero T
+	v.notify(OpClear, 0, zero)
+}
 
 // Reserve increases the capacity of the vector
-func (v *Vector[T]) Reserve(newCapacity int) {}
+// With WithMaxCapacity the request is capped at the maximum capacity
+func (v *Vector[T]) Reserve(newCapacity int) {
+	if v.maxCapacity > 0 {
+		newCapacity = min(newCapacity, v.maxCapacity)
+	}
+	if newCapacity > v.capacity {
+		v.reserve(newCapacity)
+	}
+}
 
 // Resize changes the size of the vector
-func (v *Vector[T]) Resize(newSize int, value T) {}
+func (v *Vector[T]) Resize(newSize int, value T) error {
+	newSize = max(newSize, 0)
+	if newSize < v.size {
+		v.truncate(newSize)
+		v.notify(OpResize, newSize, value)
+		return nil
+	}
+
+	if err := v.checkCapacity(newSize); err != nil {
+		return err
+	}
+	if newSize > v.capacity {
+		v.reserve(newSize)
+	}
+	for i := v.size; i < newSize; i++ {
+		v.data[i] = value
+	}
+	v.size = newSize
+	v.modCount++
+	v.notify(OpResize, newSize, value)
+	return nil
+}
 
 // Swap exchanges the contents of the vector with another vector
-func (v *Vector[T]) Swap(other *Vector[T]) {}
+func (v *Vector[T]) Swap(other *Vector[T]) {
+	modCount, otherModCount := v.modCount, other.modCount
+	observers, otherObservers := v.observers, other.observers
+	nextObserver, otherNextObserver := v.nextObserver, other.nextObserver
+	*v, *other = *other, *v
+	v.modCount, other.modCount = modCount+1, otherModCount+1
+	v.observers, other.observers = observers, otherObservers
+	v.nextObserver, other.nextObserver = nextObserver, otherNextObserver
+
+	v.notifyReset()
+	other.notifyReset()
+}
 
 // Assign replaces the contents of the vector with new values
-func (v *Vector[T]) Assign(values ...T) {}
+func (v *Vector[T]) Assign(values ...T) error {
+	if err := v.checkCapacity(len(values)); err != nil {
+		return err
+	}
+	if len(values) > v.capacity {
+		v.reserve(len(values))
+	}
+	v.detach()
+	if len(values) < v.size {
+		clear(v.data[len(values):v.size])
+	}
+	copy(v.data, values)
+	v.size = len(values)
+	v.modCount++
+	v.shrinkIfNeeded()
+	v.notifyReset()
+	return nil
+}
 
 // Begin returns the starting index for iteration
 func (v *Vector[T]) Begin() int {
@@ -146,19 +413,97 @@ func (v *Vector[T]) Begin() int {
 
 // End returns the ending index for iteration
 func (v *Vector[T]) End() int {
-	return 0
+	return v.size
 }
 
 // String returns a string representation of the vector as Vector[...]
 func (v *Vector[T]) String() string {
-	return ""
+	return fmt.Sprint(v)
 }
 
 // growCapacity calculates the new capacity when resizing is needed
 // returns new capacity
 func (v *Vector[T]) growCapacity() int {
-	return 0
+	if v.growthFunc != nil {
+		return max(v.growthFunc(v.capacity), v.capacity+1)
+	}
+	if v.capacity == 0 {
+		return 1
+	}
+	return v.capacity * 2
+}
+
+// grow ensures there is room for at least required elements,
+// growing by growCapacity or straight to required if that is not enough,
+// but never past the maximum capacity
+func (v *Vector[T]) grow(required int) error {
+	if required <= v.capacity {
+		return nil
+	}
+	if err := v.checkCapacity(required); err != nil {
+		return err
+	}
+
+	newCapacity := max(v.growCapacity(), required)
+	if v.maxCapacity > 0 {
+		newCapacity = min(newCapacity, v.maxCapacity)
+	}
+	v.reserve(newCapacity)
+	return nil
+}
+
+// checkCapacity validates that required elements fit under the maximum capacity
+func (v *Vector[T]) checkCapacity(required int) error {
+	if v.maxCapacity > 0 && required > v.maxCapacity {
+		return fmt.Errorf("%w: need %d, max %d", ErrCapacityExceeded, required, v.maxCapacity)
+	}
+	return nil
 }
 
 // reserve internal method to handle capacity changes
-func (v *Vector[T]) reserve(newCapacity int) {}
+func (v *Vector[T]) reserve(newCapacity int) {
+	v.recordRealloc()
+	data := v.allocate(newCapacity)
+	copy(data, v.data[:v.size])
+	v.data = data
+	v.capacity = newCapacity
+	v.shared = false
+}
+
+// detach copies the backing array if it is shared with a snapshot,
+// so the following in-place write cannot be observed through the snapshot
+func (v *Vector[T]) detach() {
+	if v.shared {
+		v.reserve(v.capacity)
+	}
+}
+
+// elements returns the live part of the backing array for read-only internal use
+func (v *Vector[T]) elements() []T {
+	return v.data[:v.size]
+}
+
+// truncate drops the elements past newSize, zeroing them so they can be garbage collected
+func (v *Vector[T]) truncate(newSize int) {
+	v.detach()
+	clear(v.data[newSize:v.size])
+	v.size = newSize
+	v.modCount++
+	v.shrinkIfNeeded()
+}
+
+// checkIndex validates that index lies in [0, limit)
+func (v *Vector[T]) checkIndex(index, limit int) error {
+	if index < 0 || index >= limit {
+		return fmt.Errorf("%w: index %d, size %d", ErrIndexOutOfRange, index, v.size)
+	}
+	return nil
+}
+
+// checkRange validates that [from, to) is a valid range of elements
+func (v *Vector[T]) checkRange(from, to int) error {
+	if from < 0 || to > v.size || from > to {
+		return fmt.Errorf("%w: range [%d, %d), size %d", ErrIndexOutOfRange, from, to, v.size)
+	}
+	return nil
+}
@@ -187,6 +187,32 @@ func TestPopBack(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPopBackValue(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3))
+
+	val, err := v.PopBackValue()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, val)
+	assert.Equal(t, []int{1, 2}, v.Data())
+
+	v.Clear()
+	_, err = v.PopBackValue()
+	assert.ErrorIs(t, err, ErrEmptyVector)
+}
+
+func TestPopFront(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c"))
+
+	val, err := v.PopFront()
+	assert.NoError(t, err)
+	assert.Equal(t, "a", val)
+	assert.Equal(t, []string{"b", "c"}, v.Data())
+
+	v.Clear()
+	_, err = v.PopFront()
+	assert.ErrorIs(t, err, ErrEmptyVector)
+}
+
 func TestAt(t *testing.T) {
 	v := New[string](WithValues("a", "b", "c"))
 
@@ -201,6 +227,18 @@ func TestAt(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSet(t *testing.T) {
+	v := New[string](WithValues("a", "b", "c"))
+
+	err := v.Set(1, "B")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "B", "c"}, v.Data())
+
+	assert.ErrorIs(t, v.Set(3, "d"), ErrIndexOutOfRange)
+	assert.ErrorIs(t, v.Set(-1, "d"), ErrIndexOutOfRange)
+	assert.Equal(t, 3, v.Size())
+}
+
 func TestFrontBack(t *testing.T) {
 	v := New[int](WithValues(10, 20, 30))
 
@@ -251,6 +289,97 @@ func TestErase(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEraseUnordered(t *testing.T) {
+	v := New[int](WithValues(1, 2, 3, 4, 5))
+
+	err := v.EraseUnordered(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 5, 3, 4}, v.Data())
+
+	err = v.EraseUnordered(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 5, 3}, v.Data())
+
+	err = v.EraseUnordered(3)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	single := New[int](WithValues(7))
+	assert.NoError(t, single.EraseUnordered(0))
+	assert.True(t, single.Empty())
+}
+
+func TestInsertSlice(t *testing.T) {
+	t.Run("In the middle", func(t *testing.T) {
+		v := New[int](WithValues(1, 5))
+		err := v.InsertSlice(1, []int{2, 3, 4})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, v.Data())
+	})
+
+	t.Run("At the end", func(t *testing.T) {
+		v := New[int](WithValues(1))
+		err := v.InsertSlice(1, []int{2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, v.Data())
+	})
+
+	t.Run("Into empty vector", func(t *testing.T) {
+		v := New[int]()
+		err := v.InsertSlice(0, []int{1, 2, 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, v.Data())
+		assert.Equal(t, 3, v.Capacity())
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		v := New[int](WithValues(1))
+		assert.ErrorIs(t, v.InsertSlice(3, []int{2}), ErrIndexOutOfRange)
+		assert.ErrorIs(t, v.InsertSlice(-1, []int{2}), ErrIndexOutOfRange)
+	})
+}
+
+func TestInsertVector(t *testing.T) {
+	v := New[string](WithValues("a", "d"))
+	other := New[string](WithValues("b", "c"))
+
+	err := v.InsertVector(1, other)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, v.Data())
+	assert.Equal(t, 2, other.Size())
+}
+
+func TestEraseRange(t *testing.T) {
+	t.Run("In the middle", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3, 4, 5))
+		err := v.EraseRange(1, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 5}, v.Data())
+	})
+
+	t.Run("Whole vector", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		err := v.EraseRange(0, 3)
+		assert.NoError(t, err)
+		assert.True(t, v.Empty())
+		assert.Equal(t, 3, v.Capacity())
+	})
+
+	t.Run("Empty range", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		err := v.EraseRange(2, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, v.Size())
+	})
+
+	t.Run("Invalid ranges", func(t *testing.T) {
+		v := New[int](WithValues(1, 2, 3))
+		assert.ErrorIs(t, v.EraseRange(-1, 2), ErrIndexOutOfRange)
+		assert.ErrorIs(t, v.EraseRange(1, 4), ErrIndexOutOfRange)
+		assert.ErrorIs(t, v.EraseRange(2, 1), ErrIndexOutOfRange)
+		assert.Equal(t, 3, v.Size())
+	})
+}
+
 func TestClear(t *testing.T) {
 	v := New[int](WithValues(1, 2, 3, 4, 5))
 
@@ -388,6 +517,32 @@ func TestPushBackWithPreallocatedCapacity(t *testing.T) {
 	assert.Equal(t, 100, v.Capacity())
 }
 
+func BenchmarkErase(b *testing.B) {
+	const n = 10000
+
+	b.Run("Erase", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			v := New[int](WithFill(n, 1))
+			b.StartTimer()
+			for !v.Empty() {
+				_ = v.Erase(0)
+			}
+		}
+	})
+
+	b.Run("EraseUnordered", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			v := New[int](WithFill(n, 1))
+			b.StartTimer()
+			for !v.Empty() {
+				_ = v.EraseUnordered(0)
+			}
+		}
+	})
+}
+
 // Benchmark tests with options
 func BenchmarkPushBackWithPreallocation(b *testing.B) {
 	b.Run("With capacity option", func(b *testing.B) {
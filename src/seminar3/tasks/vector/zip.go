@@ -0,0 +1,31 @@
+package vector
+
+// Pair holds two values of possibly different types
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip returns a vector of pairs combining the elements of a and b at the same index
+// The result is as long as the shorter of the two vectors
+func Zip[A, B any](a *Vector[A], b *Vector[B]) *Vector[Pair[A, B]] {
+	first, second := a.elements(), b.elements()
+	n := min(len(first), len(second))
+
+	result := New[Pair[A, B]](WithCapacity[Pair[A, B]](n))
+	for i := range n {
+		_ = result.PushBack(Pair[A, B]{First: first[i], Second: second[i]})
+	}
+	return result
+}
+
+// Unzip splits a vector of pairs into a vector of first values and a vector of second values
+func Unzip[A, B any](v *Vector[Pair[A, B]]) (*Vector[A], *Vector[B]) {
+	first := New[A](WithCapacity[A](v.Size()))
+	second := New[B](WithCapacity[B](v.Size()))
+	for _, pair := range v.elements() {
+		_ = first.PushBack(pair.First)
+		_ = second.PushBack(pair.Second)
+	}
+	return first, second
+}
@@ -0,0 +1,44 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("Equal lengths", func(t *testing.T) {
+		names := New[string](WithValues("a", "b", "c"))
+		ages := New[int](WithValues(1, 2, 3))
+
+		zipped := Zip(names, ages)
+		assert.Equal(t, []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}, zipped.Data())
+	})
+
+	t.Run("Truncates to the shorter vector", func(t *testing.T) {
+		a := New[int](WithValues(1, 2, 3, 4))
+		b := New[bool](WithValues(true, false))
+
+		zipped := Zip(a, b)
+		assert.Equal(t, []Pair[int, bool]{{1, true}, {2, false}}, zipped.Data())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.True(t, Zip(New[int](), New[int](WithValues(1))).Empty())
+	})
+}
+
+func TestUnzip(t *testing.T) {
+	pairs := New[Pair[string, int]](WithValues(
+		Pair[string, int]{"x", 10},
+		Pair[string, int]{"y", 20},
+	))
+
+	first, second := Unzip(pairs)
+	assert.Equal(t, []string{"x", "y"}, first.Data())
+	assert.Equal(t, []int{10, 20}, second.Data())
+
+	names, ages := Unzip(Zip(first, second))
+	assert.Equal(t, first.Data(), names.Data())
+	assert.Equal(t, second.Data(), ages.Data())
+}